@@ -0,0 +1,64 @@
+// Command migrate-grants reads a Terraform state file and prints the exasol_system_privilege,
+// exasol_object_privilege and exasol_role_grant configuration replacing its legacy exasol_grant
+// resources, together with the import/removed blocks (or CLI commands) that move them over
+// without revoking anything.
+//
+// Usage:
+//
+//	terraform state pull | go run ./cmd/migrate-grants > grants_migrated.tf
+//	go run ./cmd/migrate-grants -state terraform.tfstate -commands
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"terraform-provider-exasol/internal/grantmigrate"
+)
+
+func main() {
+	statePath := flag.String("state", "-", `state file to read, "-" for stdin`)
+	commands := flag.Bool("commands", false, "print terraform import / state rm commands instead of HCL")
+	flag.Parse()
+
+	if err := run(*statePath, *commands, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "migrate-grants:", err)
+		os.Exit(1)
+	}
+}
+
+func run(statePath string, commands bool, out io.Writer) error {
+	in := os.Stdin
+	if statePath != "-" {
+		f, err := os.Open(statePath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	grants, err := grantmigrate.ReadState(in)
+	if err != nil {
+		return err
+	}
+	if len(grants) == 0 {
+		fmt.Fprintln(os.Stderr, "migrate-grants: no exasol_grant resources found")
+		return nil
+	}
+
+	migrations := make([]grantmigrate.Migration, 0, len(grants))
+	for _, g := range grants {
+		m, err := grantmigrate.Convert(g)
+		if err != nil {
+			return err
+		}
+		migrations = append(migrations, m)
+	}
+	if commands {
+		return grantmigrate.WriteCommands(out, migrations)
+	}
+	return grantmigrate.WriteHCL(out, migrations)
+}
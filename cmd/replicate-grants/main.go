@@ -0,0 +1,157 @@
+// Command replicate-grants copies the grants of a user or role from a source Exasol cluster to a
+// target cluster. It reads the system privileges, object privileges, role grants and connection
+// grants of the source grantee and prints the GRANT statements the target grantee is missing,
+// rendered the same way the provider's grant resources render them. With -apply the statements
+// are executed on the target. Grants on objects, roles or connections that do not exist on the
+// target are reported and skipped. Nothing is revoked. Identifiers are quoted like with the
+// provider's default quote_identifiers = true; pass -quote-identifiers=false to match a provider
+// configured without quoting.
+//
+// The clusters are given as exasol-driver-go DSNs, preferably through the environment so that
+// passwords stay out of the shell history:
+//
+//	export EXASOL_SOURCE_DSN='exa:prod-host:8563;user=sys;password=...'
+//	export EXASOL_TARGET_DSN='exa:dr-host:8563;user=sys;password=...'
+//	go run ./cmd/replicate-grants -grantee ANALYST
+//	go run ./cmd/replicate-grants -grantee ANALYST -target-grantee ANALYST_DR -apply
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/exasol/exasol-driver-go"
+
+	"terraform-provider-exasol/internal/grantreplicate"
+	"terraform-provider-exasol/internal/resources"
+)
+
+type options struct {
+	sourceDSN     string
+	targetDSN     string
+	grantee       string
+	targetGrantee string
+	quoted        bool
+	apply         bool
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.sourceDSN, "source-dsn", os.Getenv("EXASOL_SOURCE_DSN"), "DSN of the source cluster (default $EXASOL_SOURCE_DSN)")
+	flag.StringVar(&o.targetDSN, "target-dsn", os.Getenv("EXASOL_TARGET_DSN"), "DSN of the target cluster (default $EXASOL_TARGET_DSN)")
+	flag.StringVar(&o.grantee, "grantee", "", "user or role whose grants are copied")
+	flag.StringVar(&o.targetGrantee, "target-grantee", "", "user or role receiving the grants on the target (default -grantee)")
+	flag.BoolVar(&o.quoted, "quote-identifiers", true, "quote identifiers like the provider's quote_identifiers setting")
+	flag.BoolVar(&o.apply, "apply", false, "execute the statements on the target instead of only printing them")
+	flag.Parse()
+
+	if err := run(context.Background(), o, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "replicate-grants:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options, out, log io.Writer) error {
+	if o.sourceDSN == "" || o.targetDSN == "" {
+		return errors.New("both -source-dsn and -target-dsn (or EXASOL_SOURCE_DSN and EXASOL_TARGET_DSN) are required")
+	}
+	if o.grantee == "" {
+		return errors.New("-grantee is required")
+	}
+	if o.targetGrantee == "" {
+		o.targetGrantee = o.grantee
+	}
+	o.grantee, o.targetGrantee = strings.ToUpper(o.grantee), strings.ToUpper(o.targetGrantee)
+
+	source, err := open(o.sourceDSN)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	defer source.Close()
+	target, err := open(o.targetDSN)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+	defer target.Close()
+
+	ok, err := resources.GranteeExists(ctx, source, o.grantee)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("grantee %s does not exist on the source", o.grantee)
+	}
+	ok, err = resources.GranteeExists(ctx, target, o.targetGrantee)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("grantee %s does not exist on the target; create it first", o.targetGrantee)
+	}
+
+	statements, err := plan(ctx, source, target, o, log)
+	if err != nil {
+		return err
+	}
+	if len(statements) == 0 {
+		fmt.Fprintf(log, "replicate-grants: %s already holds all grants of %s\n", o.targetGrantee, o.grantee)
+		return nil
+	}
+	for _, stmt := range statements {
+		fmt.Fprintln(out, stmt+";")
+		if !o.apply {
+			continue
+		}
+		if _, err := target.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+// plan reads the grants on both clusters and renders the statements for the missing ones,
+// reporting skipped grants to log.
+func plan(ctx context.Context, source, target *sql.DB, o options, log io.Writer) ([]string, error) {
+	want, err := grantreplicate.ReadGrants(ctx, source, o.grantee)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	have, err := grantreplicate.ReadGrants(ctx, target, o.targetGrantee)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	res, err := grantreplicate.Plan(want, have, func(g grantreplicate.Grant) (bool, error) {
+		return grantreplicate.Exists(ctx, target, g)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	for _, s := range res.Skipped {
+		fmt.Fprintf(log, "replicate-grants: skipping %s: %s\n", s.Grant, s.Reason)
+	}
+
+	statements := make([]string, 0, len(res.Apply))
+	for _, g := range res.Apply {
+		stmt, err := grantreplicate.Statement(g, o.targetGrantee, o.quoted)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", g, err)
+		}
+		statements = append(statements, stmt)
+	}
+	return statements, nil
+}
+
+func open(dsn string) (*sql.DB, error) {
+	connector, err := exasol.ExasolDriver{}.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
@@ -0,0 +1,54 @@
+package exasolclient
+
+import (
+	"context"
+	"sync"
+)
+
+type auditResourceKey struct{}
+
+// WithAuditResource labels SQL executed with ctx with the Terraform resource type that issued it,
+// for the provider's sql_audit_file.
+func WithAuditResource(ctx context.Context, resource string) context.Context {
+	return context.WithValue(ctx, auditResourceKey{}, resource)
+}
+
+// AuditResource returns the label set by WithAuditResource, or "" if there is none.
+func AuditResource(ctx context.Context) string {
+	resource, _ := ctx.Value(auditResourceKey{}).(string)
+	return resource
+}
+
+type statementRecorderKey struct{}
+
+// StatementRecorder collects the statements successfully executed with a context,
+// so a resource can report what its Create or Update did.
+type StatementRecorder struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+// WithStatementRecorder returns a context whose executed statements are collected by the returned recorder.
+func WithStatementRecorder(ctx context.Context) (context.Context, *StatementRecorder) {
+	rec := &StatementRecorder{}
+	return context.WithValue(ctx, statementRecorderKey{}, rec), rec
+}
+
+// RecordStatement adds stmt to the recorder of ctx, if there is one.
+// The connection layer calls it with statements that are already sanitized.
+func RecordStatement(ctx context.Context, stmt string) {
+	rec, ok := ctx.Value(statementRecorderKey{}).(*StatementRecorder)
+	if !ok {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.statements = append(rec.statements, stmt)
+}
+
+// Statements returns the recorded statements in execution order.
+func (r *StatementRecorder) Statements() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.statements...)
+}
@@ -1,8 +1,51 @@
 package exasolclient
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // Client is the minimal interface/resources need.
 type Client struct {
 	DB *sql.DB
+
+	// QuoteIdentifiers controls whether identifiers are emitted as "quoted"
+	// (case preserved) or bare (folded to uppercase by Exasol).
+	QuoteIdentifiers bool
+
+	// MetadataViewScope is DBA, ALL or USER and selects the EXA_*_ system views used by Read.
+	MetadataViewScope string
+
+	// QueryTimeout bounds each Create/Update/Delete; ReadTimeout bounds each Read.
+	// Zero means no limit.
+	QueryTimeout time.Duration
+	ReadTimeout  time.Duration
+
+	// DeleteTimeout bounds each Delete, including collision retries. It defaults to QueryTimeout.
+	DeleteTimeout time.Duration
+
+	// ServerMajorVersion is the Exasol major version read from EXA_METADATA, or 0 if unknown.
+	ServerMajorVersion int
+
+	// DefaultSchemaCascade is used by exasol_schema when its cascade attribute is not set.
+	DefaultSchemaCascade bool
+
+	// IgnoreMissingOnRevoke makes the grant resources' Delete treat a REVOKE of a grant, grantee
+	// or object that no longer exists as success.
+	IgnoreMissingOnRevoke bool
+
+	// ReadPermissionPolicy is fail or warn_keep and decides whether a Read denied access to a
+	// system view fails or keeps the existing state.
+	ReadPermissionPolicy string
+
+	// CollisionRetries is how often a statement that lost a transaction collision (40001) is retried.
+	CollisionRetries int
+
+	// Operations limits concurrent Create/Update/Delete calls (max_concurrent_operations).
+	// nil means unlimited.
+	Operations *OperationLimiter
+
+	// SystemViews records which of the system views the provider relies on could be queried
+	// at configure time. Editions and privileges differ, so a view may be missing.
+	SystemViews map[string]bool
 }
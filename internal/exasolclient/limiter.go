@@ -0,0 +1,38 @@
+package exasolclient
+
+import "context"
+
+// OperationLimiter caps how many Create/Update/Delete calls run against the cluster at once,
+// independently of Terraform's -parallelism. A nil limiter does not limit.
+type OperationLimiter struct {
+	slots chan struct{}
+}
+
+// NewOperationLimiter returns a limiter allowing n concurrent operations, or nil for n <= 0.
+func NewOperationLimiter(n int64) *OperationLimiter {
+	if n <= 0 {
+		return nil
+	}
+	return &OperationLimiter{slots: make(chan struct{}, n)}
+}
+
+// Acquire waits for a free slot or for ctx to end.
+func (l *OperationLimiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (l *OperationLimiter) Release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
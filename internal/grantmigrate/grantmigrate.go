@@ -0,0 +1,281 @@
+// Package grantmigrate converts legacy exasol_grant resources into the dedicated
+// exasol_system_privilege, exasol_object_privilege and exasol_role_grant resources.
+//
+// The resource types differ, so `terraform state mv` cannot carry a grant over. Instead the
+// generated configuration pairs each new resource with an import block (Terraform 1.5+) using
+// the new resource's import ID, and each old resource with a removed block (Terraform 1.7+) so
+// Terraform forgets it without revoking the grant. Nothing is revoked or re-granted.
+package grantmigrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LegacyGrant holds the attributes of one exasol_grant instance.
+type LegacyGrant struct {
+	// Address is the resource address in the state, e.g. module.db.exasol_grant.usage["ANALYST"].
+	Address string
+	// Module is the module path of the address, "" for the root module.
+	Module string
+	// Name is the resource name, with the instance key appended for count/for_each instances.
+	Name string
+
+	GranteeName     string
+	PrivilegeType   string
+	Privilege       string
+	ObjectType      string
+	ObjectName      string
+	WithAdminOption bool
+}
+
+// Migration is the replacement of one legacy grant.
+type Migration struct {
+	From LegacyGrant
+	// ResourceType is the new resource type.
+	ResourceType string
+	// Name is the new resource name.
+	Name string
+	// Attributes are the new resource's arguments in output order.
+	Attributes []Attribute
+	// ImportID is the new resource's import ID.
+	ImportID string
+}
+
+// Attribute is one argument of a generated resource block.
+type Attribute struct {
+	Name  string
+	Value any // string, bool or []string
+}
+
+// To returns the address of the new resource.
+func (m Migration) To() string {
+	addr := m.ResourceType + "." + m.Name
+	if m.From.Module != "" {
+		addr = m.From.Module + "." + addr
+	}
+	return addr
+}
+
+// ReadState returns the exasol_grant instances of a Terraform state file (format version 4).
+func ReadState(r io.Reader) ([]LegacyGrant, error) {
+	var state struct {
+		Version   int `json:"version"`
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   any             `json:"index_key"`
+				Attributes json.RawMessage `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return nil, fmt.Errorf("parse state: %w", err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state format version %d, expected 4", state.Version)
+	}
+
+	var grants []LegacyGrant
+	for _, res := range state.Resources {
+		if res.Mode != "managed" || res.Type != "exasol_grant" {
+			continue
+		}
+		for _, inst := range res.Instances {
+			var attrs struct {
+				GranteeName     string `json:"grantee_name"`
+				PrivilegeType   string `json:"privilege_type"`
+				Privilege       string `json:"privilege"`
+				ObjectType      string `json:"object_type"`
+				ObjectName      string `json:"object_name"`
+				WithAdminOption bool   `json:"with_admin_option"`
+			}
+			if err := json.Unmarshal(inst.Attributes, &attrs); err != nil {
+				return nil, fmt.Errorf("parse attributes of exasol_grant.%s: %w", res.Name, err)
+			}
+			address, name := res.Type+"."+res.Name, res.Name
+			switch key := inst.IndexKey.(type) {
+			case string:
+				address += fmt.Sprintf("[%q]", key)
+				name += "_" + key
+			case float64:
+				address += fmt.Sprintf("[%d]", int(key))
+				name += fmt.Sprintf("_%d", int(key))
+			}
+			if res.Module != "" {
+				address = res.Module + "." + address
+			}
+			grants = append(grants, LegacyGrant{
+				Address:         address,
+				Module:          res.Module,
+				Name:            resourceName(name),
+				GranteeName:     attrs.GranteeName,
+				PrivilegeType:   attrs.PrivilegeType,
+				Privilege:       attrs.Privilege,
+				ObjectType:      attrs.ObjectType,
+				ObjectName:      attrs.ObjectName,
+				WithAdminOption: attrs.WithAdminOption,
+			})
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool { return grants[i].Address < grants[j].Address })
+	return grants, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// resourceName turns a resource name with an instance key into a valid Terraform identifier.
+func resourceName(name string) string {
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "grant_" + name
+	}
+	return name
+}
+
+// Convert maps a legacy grant to its dedicated resource, following the same rules exasol_grant
+// uses to build its SQL: object_type ROLE is a role grant (the role comes from object_name for
+// privilege_type OBJECT, from privilege otherwise), SYSTEM is a system privilege and OBJECT an
+// object privilege.
+func Convert(g LegacyGrant) (Migration, error) {
+	grantee := strings.ToUpper(g.GranteeName)
+	if grantee == "" {
+		return Migration{}, fmt.Errorf("%s: grantee_name is empty", g.Address)
+	}
+	privilege := normalizePrivilege(g.Privilege)
+	objectType := strings.ToUpper(g.ObjectType)
+	m := Migration{From: g, Name: g.Name}
+
+	if objectType == "ROLE" {
+		role := privilege
+		if strings.EqualFold(g.PrivilegeType, "OBJECT") && g.ObjectName != "" {
+			role = strings.ToUpper(g.ObjectName)
+		}
+		m.ResourceType = "exasol_role_grant"
+		m.Attributes = []Attribute{{"role", role}, {"grantee", grantee}}
+		if g.WithAdminOption {
+			m.Attributes = append(m.Attributes, Attribute{"with_admin_option", true})
+		}
+		m.ImportID = fmt.Sprintf("%s|%s|%t", role, grantee, g.WithAdminOption)
+		return m, nil
+	}
+
+	switch strings.ToUpper(g.PrivilegeType) {
+	case "SYSTEM":
+		m.ResourceType = "exasol_system_privilege"
+		m.Attributes = []Attribute{{"grantee", grantee}, {"privilege", privilege}}
+		if g.WithAdminOption {
+			m.Attributes = append(m.Attributes, Attribute{"with_admin_option", true})
+		}
+		m.ImportID = fmt.Sprintf("%s|%s|%t", grantee, privilege, g.WithAdminOption)
+	case "OBJECT":
+		if objectType == "" || g.ObjectName == "" {
+			return Migration{}, fmt.Errorf("%s: object_type and object_name are required for OBJECT privileges", g.Address)
+		}
+		m.ResourceType = "exasol_object_privilege"
+		m.Attributes = []Attribute{
+			{"grantee", grantee},
+			{"privileges", []string{privilege}},
+			{"object_type", objectType},
+			{"object_name", g.ObjectName},
+		}
+		m.ImportID = fmt.Sprintf("%s|%s|%s|%s", grantee, privilege, objectType, g.ObjectName)
+	default:
+		return Migration{}, fmt.Errorf("%s: privilege_type must be SYSTEM or OBJECT, got %q", g.Address, g.PrivilegeType)
+	}
+	return m, nil
+}
+
+// normalizePrivilege matches the provider's normalization: uppercase with single spaces.
+func normalizePrivilege(privilege string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(privilege)), " ")
+}
+
+// WriteHCL writes, for each migration, the new resource block, an import block for it and a
+// removed block for the legacy resource. Resources in child modules only get the resource block
+// and a pointer to WriteCommands, because the blocks would have to be placed in the module.
+func WriteHCL(w io.Writer, migrations []Migration) error {
+	var b strings.Builder
+	removed := map[string]bool{}
+	for i, m := range migrations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "# Replaces %s\n", m.From.Address)
+		fmt.Fprintf(&b, "resource %q %q {\n", m.ResourceType, m.Name)
+		width := 0
+		for _, a := range m.Attributes {
+			width = max(width, len(a.Name))
+		}
+		for _, a := range m.Attributes {
+			fmt.Fprintf(&b, "  %-*s = %s\n", width, a.Name, hclValue(a.Value))
+		}
+		b.WriteString("}\n")
+		if m.From.Module != "" {
+			fmt.Fprintf(&b, "# Declare this resource in %s and move the state with the -commands output.\n", m.From.Module)
+			continue
+		}
+		fmt.Fprintf(&b, "\nimport {\n  to = %s.%s\n  id = %s\n}\n", m.ResourceType, m.Name, hclString(m.ImportID))
+		// A removed block covers all instances of a count/for_each resource, so emit it once
+		if legacy := legacyLocalName(m.From.Address); !removed[legacy] {
+			removed[legacy] = true
+			fmt.Fprintf(&b, "\nremoved {\n  from = exasol_grant.%s\n\n  lifecycle {\n    destroy = false\n  }\n}\n", legacy)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteCommands writes the CLI equivalent of the import and removed blocks, for Terraform
+// versions before 1.7: import each new resource, then drop the legacy one from state.
+func WriteCommands(w io.Writer, migrations []Migration) error {
+	var b strings.Builder
+	for _, m := range migrations {
+		fmt.Fprintf(&b, "terraform import %s %s\n", shellQuote(m.To()), shellQuote(m.ImportID))
+		fmt.Fprintf(&b, "terraform state rm %s\n", shellQuote(m.From.Address))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// legacyLocalName returns the resource name of a legacy address without module path or
+// instance key; removed blocks address the whole resource.
+func legacyLocalName(address string) string {
+	name := address[strings.LastIndex(address, "exasol_grant.")+len("exasol_grant."):]
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+func hclValue(v any) string {
+	switch v := v.(type) {
+	case bool:
+		return fmt.Sprintf("%t", v)
+	case []string:
+		items := make([]string, len(v))
+		for i, s := range v {
+			items[i] = hclString(s)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return hclString(fmt.Sprint(v))
+	}
+}
+
+// hclString renders a quoted HCL string, escaping template sequences as well as quotes.
+func hclString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "${", "$${", "%{", "%%{")
+	return `"` + r.Replace(s) + `"`
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,234 @@
+// Package grantreplicate copies the grants of a user or role from one Exasol cluster to another.
+//
+// ReadGrants collects the system privileges, object privileges, role grants and connection grants
+// a grantee holds, Plan works out which of them the target grantee is missing, and Statement
+// renders each missing grant with the same builders the grant resources use. Reading and applying
+// are kept apart from the planning so that the diff can be checked without a database.
+package grantreplicate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-exasol/internal/resources"
+)
+
+// Grant kinds, named after the system view each kind is read from.
+const (
+	KindSystem     = "SYSTEM"     // EXA_DBA_SYS_PRIVS
+	KindObject     = "OBJECT"     // EXA_DBA_OBJ_PRIVS
+	KindRole       = "ROLE"       // EXA_DBA_ROLE_PRIVS
+	KindConnection = "CONNECTION" // EXA_DBA_CONNECTION_PRIVS
+)
+
+// Grant is one grant held by a grantee.
+type Grant struct {
+	Kind string
+	// Privilege is the system or object privilege, the granted role, or the granted connection.
+	Privilege string
+	// ObjectType and ObjectName are set for KindObject; ObjectName is SCHEMA.OBJECT for schema
+	// objects and the bare name for schemas and connections.
+	ObjectType  string
+	ObjectName  string
+	AdminOption bool
+}
+
+// key identifies the grant independent of its admin option.
+func (g Grant) key() string {
+	return strings.Join([]string{g.Kind, g.Privilege, g.ObjectType, g.ObjectName}, "\x00")
+}
+
+// String describes the grant for messages.
+func (g Grant) String() string {
+	var s string
+	switch g.Kind {
+	case KindObject:
+		s = fmt.Sprintf("%s ON %s %s", g.Privilege, g.ObjectType, g.ObjectName)
+	case KindConnection:
+		s = "CONNECTION " + g.Privilege
+	default:
+		s = g.Privilege
+	}
+	if g.AdminOption {
+		s += " WITH ADMIN OPTION"
+	}
+	return s
+}
+
+// ReadGrants returns the grants held directly by grantee, sorted by kind and name.
+func ReadGrants(ctx context.Context, db *sql.DB, grantee string) ([]Grant, error) {
+	grantee = strings.ToUpper(grantee)
+	var grants []Grant
+
+	err := query(ctx, db, `SELECT PRIVILEGE, ADMIN_OPTION FROM EXA_DBA_SYS_PRIVS WHERE GRANTEE = ?`, grantee,
+		func(rows *sql.Rows) error {
+			var g Grant
+			var admin any
+			if err := rows.Scan(&g.Privilege, &admin); err != nil {
+				return err
+			}
+			g.Kind, g.AdminOption = KindSystem, resources.ParseAdminOption(admin)
+			grants = append(grants, g)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("reading system privileges: %w", err)
+	}
+
+	err = query(ctx, db, `SELECT PRIVILEGE, OBJECT_TYPE, OBJECT_SCHEMA, OBJECT_NAME FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ?`, grantee,
+		func(rows *sql.Rows) error {
+			var g Grant
+			var schemaName sql.NullString
+			if err := rows.Scan(&g.Privilege, &g.ObjectType, &schemaName, &g.ObjectName); err != nil {
+				return err
+			}
+			g.Kind = KindObject
+			if schemaName.Valid && schemaName.String != "" {
+				g.ObjectName = schemaName.String + "." + g.ObjectName
+			}
+			grants = append(grants, g)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("reading object privileges: %w", err)
+	}
+
+	err = query(ctx, db, `SELECT GRANTED_ROLE, ADMIN_OPTION FROM EXA_DBA_ROLE_PRIVS WHERE GRANTEE = ?`, grantee,
+		func(rows *sql.Rows) error {
+			var g Grant
+			var admin any
+			if err := rows.Scan(&g.Privilege, &admin); err != nil {
+				return err
+			}
+			g.Kind, g.AdminOption = KindRole, resources.ParseAdminOption(admin)
+			grants = append(grants, g)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("reading role grants: %w", err)
+	}
+
+	err = query(ctx, db, `SELECT GRANTED_CONNECTION, ADMIN_OPTION FROM EXA_DBA_CONNECTION_PRIVS WHERE GRANTEE = ?`, grantee,
+		func(rows *sql.Rows) error {
+			var g Grant
+			var admin any
+			if err := rows.Scan(&g.Privilege, &admin); err != nil {
+				return err
+			}
+			g.Kind, g.AdminOption = KindConnection, resources.ParseAdminOption(admin)
+			grants = append(grants, g)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("reading connection grants: %w", err)
+	}
+
+	sortGrants(grants)
+	return grants, nil
+}
+
+func query(ctx context.Context, db *sql.DB, q, grantee string, scan func(*sql.Rows) error) error {
+	rows, err := db.QueryContext(ctx, q, grantee)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func sortGrants(grants []Grant) {
+	sort.Slice(grants, func(i, j int) bool { return grants[i].key() < grants[j].key() })
+}
+
+// Skipped is a source grant that cannot be replicated.
+type Skipped struct {
+	Grant  Grant
+	Reason string
+}
+
+// Result is the outcome of Plan.
+type Result struct {
+	// Apply holds the grants the target grantee is missing, or holds without the admin option
+	// the source grantee has.
+	Apply []Grant
+	// Skipped holds missing grants whose object, role or connection does not exist on the target.
+	Skipped []Skipped
+}
+
+// Plan compares the grants of the source grantee with those the target grantee already holds.
+// exists reports whether the object, role or connection a grant refers to exists on the target;
+// it is not called for system privileges.
+func Plan(source, target []Grant, exists func(Grant) (bool, error)) (Result, error) {
+	held := make(map[string]Grant, len(target))
+	for _, g := range target {
+		held[g.key()] = g
+	}
+
+	var res Result
+	for _, g := range source {
+		if t, ok := held[g.key()]; ok && (t.AdminOption || !g.AdminOption) {
+			continue
+		}
+		if g.Kind != KindSystem {
+			ok, err := exists(g)
+			if err != nil {
+				return Result{}, fmt.Errorf("checking %s: %w", g, err)
+			}
+			if !ok {
+				res.Skipped = append(res.Skipped, Skipped{Grant: g, Reason: missingReason(g)})
+				continue
+			}
+		}
+		res.Apply = append(res.Apply, g)
+	}
+	return res, nil
+}
+
+func missingReason(g Grant) string {
+	switch g.Kind {
+	case KindRole:
+		return fmt.Sprintf("role %s does not exist on the target", g.Privilege)
+	case KindConnection:
+		return fmt.Sprintf("connection %s does not exist on the target", g.Privilege)
+	default:
+		return fmt.Sprintf("%s %s does not exist on the target", g.ObjectType, g.ObjectName)
+	}
+}
+
+// Exists reports whether the object, role or connection g refers to exists in db.
+func Exists(ctx context.Context, db *sql.DB, g Grant) (bool, error) {
+	switch g.Kind {
+	case KindSystem:
+		return true, nil
+	case KindRole:
+		return resources.GrantObjectExists(ctx, db, "ROLE", g.Privilege)
+	case KindConnection:
+		return resources.GrantObjectExists(ctx, db, "CONNECTION", g.Privilege)
+	default:
+		return resources.GrantObjectExists(ctx, db, g.ObjectType, g.ObjectName)
+	}
+}
+
+// Statement renders the GRANT statement giving g to grantee.
+func Statement(g Grant, grantee string, quoted bool) (string, error) {
+	switch g.Kind {
+	case KindSystem:
+		return resources.GrantSQL(grantee, "SYSTEM", g.Privilege, "", "", g.AdminOption, quoted)
+	case KindRole:
+		return resources.GrantSQL(grantee, "SYSTEM", g.Privilege, "ROLE", "", g.AdminOption, quoted)
+	case KindConnection:
+		return resources.ConnectionGrantSQL(g.Privilege, strings.ToUpper(grantee), g.AdminOption, quoted)
+	case KindObject:
+		return resources.GrantSQL(grantee, "OBJECT", g.Privilege, g.ObjectType, g.ObjectName, false, quoted)
+	default:
+		return "", fmt.Errorf("unknown grant kind %q", g.Kind)
+	}
+}
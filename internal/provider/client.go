@@ -3,12 +3,19 @@ package provider
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
+	"terraform-provider-exasol/internal/resources"
 
 	"github.com/exasol/exasol-driver-go"
 	"github.com/exasol/exasol-driver-go/pkg/dsn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Re-export the concrete type so the rest of the provider can keep using provider.Client.
@@ -18,27 +25,267 @@ type Client = exasolclient.Client
 // It now always includes the `encryption` flag, and lets the caller
 // control whether the server certificate is validated.
 func NewClient(ctx context.Context, c *ProviderConfig) (*Client, error) {
-	var config *dsn.DSNConfigBuilder
+	if c.DatabaseID == "" {
+		if err := checkHostsResolve(ctx, splitHosts(c.Host)); err != nil {
+			return nil, err
+		}
+	}
+	dsnString := buildDSN(c)
+
+	var audit *sqlAuditLog
+	if c.SQLAuditFile != "" {
+		var err error
+		if audit, err = openSQLAuditLog(c.SQLAuditFile); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := connectWithRetry(ctx, c.ConnectRetries, time.Duration(c.ConnectRetryDelaySeconds)*time.Second,
+		func() (*sql.DB, error) {
+			db, err := openDB(dsnString, audit, c)
+			if err != nil {
+				return nil, err
+			}
+			pingCtx, cancel := context.WithCancel(ctx)
+			if c.ConnectTimeoutSeconds > 0 {
+				pingCtx, cancel = context.WithTimeout(ctx, time.Duration(c.ConnectTimeoutSeconds)*time.Second)
+			}
+			defer cancel()
+			if err := db.PingContext(pingCtx); err != nil {
+				db.Close()
+				return nil, err
+			}
+			return db, nil
+		})
+	if err != nil {
+		audit.Close()
+		return nil, err
+	}
+
+	if len(c.ActiveRoles) > 0 {
+		if err := verifyActiveRoles(ctx, db, c.ActiveRoles); err != nil {
+			db.Close()
+			audit.Close()
+			return nil, err
+		}
+	}
 
-	// Detect if password is a PAT token
-	if strings.HasPrefix(c.Password, "exa_pat_") {
+	return &Client{
+		DB:                    db,
+		ServerMajorVersion:    serverMajorVersion(ctx, db),
+		QuoteIdentifiers:      c.QuoteIdentifiers,
+		MetadataViewScope:     c.MetadataViewScope,
+		QueryTimeout:          time.Duration(c.QueryTimeoutSeconds) * time.Second,
+		ReadTimeout:           time.Duration(c.ReadTimeoutSeconds) * time.Second,
+		DeleteTimeout:         time.Duration(c.DeleteTimeoutSeconds) * time.Second,
+		DefaultSchemaCascade:  c.DefaultSchemaCascade,
+		IgnoreMissingOnRevoke: c.IgnoreMissingOnRevoke,
+		ReadPermissionPolicy:  c.OnReadPermissionError,
+		CollisionRetries:      int(c.CollisionRetries),
+		Operations:            exasolclient.NewOperationLimiter(c.MaxConcurrentOperations),
+		SystemViews:           probeSystemViews(ctx, db, c.MetadataViewScope),
+	}, nil
+}
+
+// saasHostDomain is the DNS domain of Exasol SaaS databases; the host is <database_id>.<domain>.
+const saasHostDomain = "clusters.exasol.com"
+
+// isPersonalAccessToken reports whether password is an Exasol personal access token.
+func isPersonalAccessToken(password string) bool {
+	return strings.HasPrefix(password, "exa_pat_")
+}
+
+// checkHostsResolve fails when none of the hosts resolves, so a typo in the host list is reported
+// as such instead of as a connection timeout. Hosts that do not resolve are logged; the driver
+// tries the remaining ones. Entries in the driver's range notation (exasol1..3) are left to it.
+func checkHostsResolve(ctx context.Context, hosts []string) error {
+	var unresolved []string
+	for _, host := range hosts {
+		if strings.Contains(host, "..") {
+			return nil
+		}
+		if _, err := net.DefaultResolver.LookupHost(ctx, host); err != nil {
+			tflog.Warn(ctx, "Exasol host does not resolve", map[string]any{"host": host, "error": err.Error()})
+			unresolved = append(unresolved, host)
+		}
+	}
+	if len(unresolved) == len(hosts) {
+		return fmt.Errorf("none of the Exasol hosts resolves: %s", strings.Join(unresolved, ", "))
+	}
+	return nil
+}
+
+// buildDSN renders the driver DSN. host may list several comma-separated hosts; the driver
+// connects to them in random order and fails over to the next one. A personal access token is sent as refresh token instead of
+// a password; with database_id the host is the SaaS host of that database. A certificate
+// fingerprint makes the driver check the certificate against it instead of the system CAs.
+func buildDSN(c *ProviderConfig) string {
+	var config *dsn.DSNConfigBuilder
+	if isPersonalAccessToken(c.Password) {
 		config = exasol.NewConfigWithRefreshToken(c.Password) // Use PAT as refresh token
 	} else {
 		config = exasol.NewConfig(c.User, c.Password) // Use regular password
 	}
 
-	dsnString := config.Host(c.Host).
+	host := c.Host
+	if c.DatabaseID != "" {
+		host = strings.ToLower(c.DatabaseID) + "." + saasHostDomain
+	}
+	return config.Host(host).
 		Port(int(c.Port)).
 		ValidateServerCertificate(c.ValidateServerCertificate).
+		CertificateFingerprint(c.CertificateFingerprint).
 		String()
+}
+
+// requiredSystemView is a system view Read depends on and the features that need it.
+type requiredSystemView struct {
+	name     string
+	features string
+}
 
-	db, err := sql.Open("exasol", dsnString)
+// requiredSystemViews lists the views Read depends on. The privilege and role views follow
+// metadata_view_scope; exasol_system_privilege and exasol_connection_grant always read the DBA views.
+func requiredSystemViews(scope string) []requiredSystemView {
+	objPrivs, rolePrivs, roles := resources.ScopedMetadataViews(scope)
+	views := []requiredSystemView{
+		{"EXA_DBA_SYS_PRIVS", "exasol_system_privilege cannot be read"},
+		{objPrivs, "exasol_object_privilege and OBJECT grants of exasol_grant cannot be read with metadata_view_scope = " + scope},
+	}
+	for _, v := range rolePrivs {
+		views = append(views, requiredSystemView{v, "exasol_role_grant and exasol_role_assignments cannot be read with metadata_view_scope = " + scope})
+	}
+	return append(views,
+		requiredSystemView{roles, "exasol_role cannot be read with metadata_view_scope = " + scope},
+		requiredSystemView{"EXA_DBA_CONNECTION_PRIVS", "exasol_connection_grant cannot be read and check_connection_access is skipped"},
+	)
+}
+
+// probeSystemViews checks which required system views the provider user can query. The probe
+// selects no rows, so it only fails if the view does not exist or is not accessible.
+func probeSystemViews(ctx context.Context, db *sql.DB, scope string) map[string]bool {
+	views := requiredSystemViews(scope)
+	available := make(map[string]bool, len(views))
+	for _, v := range views {
+		rows, err := db.QueryContext(ctx, fmt.Sprintf(`SELECT 1 FROM %s WHERE FALSE`, v.name))
+		if err == nil {
+			rows.Close()
+		} else {
+			tflog.Debug(ctx, "System view not available", map[string]any{"view": v.name, "error": err.Error()})
+		}
+		available[v.name] = err == nil
+	}
+	return available
+}
+
+// missingSystemViewWarnings turns a failed probe into one warning per view.
+func missingSystemViewWarnings(available map[string]bool, scope string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, v := range requiredSystemViews(scope) {
+		if ok, probed := available[v.name]; probed && !ok {
+			diags.AddWarning("System view "+v.name+" not available",
+				fmt.Sprintf("The provider user cannot query %s, so %s. Grant SELECT ANY DICTIONARY to the provider user, "+
+					"or check that your Exasol edition provides the view.", v.name, v.features))
+		}
+	}
+	return diags
+}
+
+// connectWithRetry calls connect up to retries+1 times, doubling the delay between
+// attempts. It gives up early when ctx is cancelled and returns the last error.
+func connectWithRetry(ctx context.Context, retries int64, delay time.Duration, connect func() (*sql.DB, error)) (*sql.DB, error) {
+	var lastErr error
+	for attempt := int64(0); attempt <= retries; attempt++ {
+		if attempt > 0 {
+			tflog.Warn(ctx, "Connecting to Exasol failed, retrying", map[string]any{
+				"attempt":    attempt,
+				"maxRetries": retries,
+				"waitMs":     delay.Milliseconds(),
+				"error":      lastErr.Error(),
+			})
+			select {
+			case <-ctx.Done():
+				return nil, lastErr
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		db, err := connect()
+		if err == nil {
+			return db, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// serverMajorVersion reads the Exasol major version from EXA_METADATA. Failures are logged and
+// reported as 0 so that version-dependent checks fall back to accepting everything.
+func serverMajorVersion(ctx context.Context, db *sql.DB) int {
+	var version string
+	err := db.QueryRowContext(ctx,
+		`SELECT PARAM_VALUE FROM EXA_METADATA WHERE PARAM_NAME = 'databaseProductVersion'`).Scan(&version)
 	if err != nil {
-		return nil, err
+		tflog.Warn(ctx, "Could not determine Exasol version", map[string]any{"error": err.Error()})
+		return 0
+	}
+	major, err := strconv.Atoi(strings.SplitN(strings.TrimSpace(version), ".", 2)[0])
+	if err != nil {
+		tflog.Warn(ctx, "Could not parse Exasol version", map[string]any{"version": version})
+		return 0
 	}
-	if err := db.PingContext(ctx); err != nil {
+	tflog.Debug(ctx, "Detected Exasol version", map[string]any{"version": version, "major": major})
+	return major
+}
+
+// openDB opens the Exasol connection pool. Connections are wrapped so executed statements
+// are recorded for last_applied_sql and, with an audit log, in the sql_audit_file. With tag,
+// executed statements are prefixed with a comment naming the resource type.
+func openDB(dsnString string, audit *sqlAuditLog, c *ProviderConfig) (*sql.DB, error) {
+	connector, err := exasol.ExasolDriver{}.OpenConnector(dsnString)
+	if err != nil {
 		return nil, err
 	}
+	return sql.OpenDB(&auditConnector{
+		Connector:       connector,
+		audit:           audit,
+		tag:             c.StatementTag,
+		validationQuery: c.ValidationQuery,
+		profile:         c.SessionProfiling,
+	}), nil
+}
 
-	return &Client{DB: db}, nil
+// verifyActiveRoles checks that each role is granted to the current user, directly or through
+// another role. Exasol activates all granted roles for every session, so nothing needs to be set.
+func verifyActiveRoles(ctx context.Context, db *sql.DB, roles []string) error {
+	rows, err := db.QueryContext(ctx, `SELECT GRANTED_ROLE FROM EXA_USER_ROLE_PRIVS
+		UNION SELECT GRANTED_ROLE FROM EXA_ROLE_ROLE_PRIVS`)
+	if err != nil {
+		return fmt.Errorf("read granted roles: %w", err)
+	}
+	defer rows.Close()
+
+	granted := map[string]bool{"PUBLIC": true}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return fmt.Errorf("read granted roles: %w", err)
+		}
+		granted[role] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read granted roles: %w", err)
+	}
+
+	var missing []string
+	for _, role := range roles {
+		if !granted[strings.ToUpper(role)] && !granted[role] {
+			missing = append(missing, role)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("active_roles not granted to the provider user: %s", strings.Join(missing, ", "))
+	}
+	return nil
 }
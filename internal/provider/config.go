@@ -2,18 +2,46 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"terraform-provider-exasol/internal/resources"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ProviderConfig struct {
 	Host                      string
+	DatabaseID                string
 	Port                      int64
 	User                      string
 	Password                  string
 	ValidateServerCertificate bool
+	CertificateFingerprint    string
+	QuoteIdentifiers          bool
+	ConnectRetries            int64
+	ConnectRetryDelaySeconds  int64
+	MetadataViewScope         string
+	ConnectTimeoutSeconds     int64
+	QueryTimeoutSeconds       int64
+	ReadTimeoutSeconds        int64
+	DeleteTimeoutSeconds      int64
+	MaxConcurrentOperations   int64
+	CollisionRetries          int64
+	DefaultSchemaCascade      bool
+	IgnoreMissingOnRevoke     bool
+	SQLAuditFile              string
+	StatementTag              bool
+	ValidationQuery           string
+	SessionProfiling          bool
+	OnReadPermissionError     string
+	ActiveRoles               []string
 }
 
 func LoadConfig(ctx context.Context, req provider.ConfigureRequest) (*ProviderConfig, diag.Diagnostics) {
@@ -21,19 +49,65 @@ func LoadConfig(ctx context.Context, req provider.ConfigureRequest) (*ProviderCo
 
 	var cfg struct {
 		Host                      types.String `tfsdk:"host"`
+		DatabaseID                types.String `tfsdk:"database_id"`
 		Port                      types.Int64  `tfsdk:"port"`
 		User                      types.String `tfsdk:"user"`
 		Password                  types.String `tfsdk:"password"`
 		ValidateServerCertificate types.Bool   `tfsdk:"validate_server_certificate"`
+		CertificateFingerprint    types.String `tfsdk:"certificate_fingerprint"`
+		QuoteIdentifiers          types.Bool   `tfsdk:"quote_identifiers"`
+		ConnectRetries            types.Int64  `tfsdk:"connect_retries"`
+		ConnectRetryDelaySeconds  types.Int64  `tfsdk:"connect_retry_delay_seconds"`
+		MetadataViewScope         types.String `tfsdk:"metadata_view_scope"`
+		ConnectTimeoutSeconds     types.Int64  `tfsdk:"connect_timeout_seconds"`
+		QueryTimeoutSeconds       types.Int64  `tfsdk:"query_timeout_seconds"`
+		ReadTimeoutSeconds        types.Int64  `tfsdk:"read_timeout_seconds"`
+		DeleteTimeoutSeconds      types.Int64  `tfsdk:"delete_timeout_seconds"`
+		MaxConcurrentOperations   types.Int64  `tfsdk:"max_concurrent_operations"`
+		CollisionRetries          types.Int64  `tfsdk:"collision_retries"`
+		DefaultSchemaCascade      types.Bool   `tfsdk:"default_schema_cascade"`
+		IgnoreMissingOnRevoke     types.Bool   `tfsdk:"ignore_missing_on_revoke"`
+		SQLAuditFile              types.String `tfsdk:"sql_audit_file"`
+		StatementTag              types.Bool   `tfsdk:"statement_tag"`
+		ValidationQuery           types.String `tfsdk:"validation_query"`
+		SessionProfiling          types.Bool   `tfsdk:"session_profiling"`
+		OnReadPermissionError     types.String `tfsdk:"on_read_permission_error"`
+		ActiveRoles               types.List   `tfsdk:"active_roles"`
 	}
 	diags.Append(req.Config.Get(ctx, &cfg)...)
 
 	out := &ProviderConfig{
 		Host:                      cfg.Host.ValueString(),
+		DatabaseID:                strings.TrimSpace(cfg.DatabaseID.ValueString()),
 		Port:                      8563,
 		User:                      cfg.User.ValueString(),
 		Password:                  cfg.Password.ValueString(),
+		SQLAuditFile:              cfg.SQLAuditFile.ValueString(),
+		StatementTag:              cfg.StatementTag.ValueBool(),
+		ValidationQuery:           strings.TrimSpace(cfg.ValidationQuery.ValueString()),
+		SessionProfiling:          cfg.SessionProfiling.ValueBool(),
 		ValidateServerCertificate: true,
+		QuoteIdentifiers:          true,
+		ConnectRetries:            0,
+		ConnectRetryDelaySeconds:  5,
+		MetadataViewScope:         resources.ViewScopeDBA,
+		ConnectTimeoutSeconds:     30,
+		QueryTimeoutSeconds:       0,
+		ReadTimeoutSeconds:        0,
+		DefaultSchemaCascade:      true,
+		IgnoreMissingOnRevoke:     true,
+		OnReadPermissionError:     resources.ReadPermissionErrorFail,
+	}
+	// Credentials not set in the configuration are taken from the environment. EXASOL_HOST is not
+	// used with database_id, which selects the host itself.
+	if cfg.Host.IsNull() && out.DatabaseID == "" {
+		out.Host = os.Getenv("EXASOL_HOST")
+	}
+	if cfg.User.IsNull() {
+		out.User = os.Getenv("EXASOL_USER")
+	}
+	if cfg.Password.IsNull() {
+		out.Password = os.Getenv("EXASOL_PASSWORD")
 	}
 	if !cfg.Port.IsNull() {
 		out.Port = cfg.Port.ValueInt64()
@@ -41,6 +115,155 @@ func LoadConfig(ctx context.Context, req provider.ConfigureRequest) (*ProviderCo
 	if !cfg.ValidateServerCertificate.IsNull() {
 		out.ValidateServerCertificate = cfg.ValidateServerCertificate.ValueBool()
 	}
+	if !cfg.QuoteIdentifiers.IsNull() {
+		out.QuoteIdentifiers = cfg.QuoteIdentifiers.ValueBool()
+	}
+	if !cfg.ConnectRetries.IsNull() {
+		out.ConnectRetries = cfg.ConnectRetries.ValueInt64()
+	}
+	if !cfg.ConnectRetryDelaySeconds.IsNull() {
+		out.ConnectRetryDelaySeconds = cfg.ConnectRetryDelaySeconds.ValueInt64()
+	}
+	if !cfg.ConnectTimeoutSeconds.IsNull() {
+		out.ConnectTimeoutSeconds = cfg.ConnectTimeoutSeconds.ValueInt64()
+	}
+	if !cfg.QueryTimeoutSeconds.IsNull() {
+		out.QueryTimeoutSeconds = cfg.QueryTimeoutSeconds.ValueInt64()
+	}
+	if !cfg.ReadTimeoutSeconds.IsNull() {
+		out.ReadTimeoutSeconds = cfg.ReadTimeoutSeconds.ValueInt64()
+	}
+	// Deletes fall back to the create/update limit
+	out.DeleteTimeoutSeconds = out.QueryTimeoutSeconds
+	if !cfg.DeleteTimeoutSeconds.IsNull() {
+		out.DeleteTimeoutSeconds = cfg.DeleteTimeoutSeconds.ValueInt64()
+	}
+	if !cfg.MaxConcurrentOperations.IsNull() {
+		out.MaxConcurrentOperations = cfg.MaxConcurrentOperations.ValueInt64()
+	}
+	out.CollisionRetries = resources.DefaultCollisionRetries
+	if !cfg.CollisionRetries.IsNull() {
+		out.CollisionRetries = cfg.CollisionRetries.ValueInt64()
+	}
+	if !cfg.ActiveRoles.IsNull() && !cfg.ActiveRoles.IsUnknown() {
+		diags.Append(cfg.ActiveRoles.ElementsAs(ctx, &out.ActiveRoles, false)...)
+	}
+	if !cfg.DefaultSchemaCascade.IsNull() {
+		out.DefaultSchemaCascade = cfg.DefaultSchemaCascade.ValueBool()
+	}
+	if !cfg.IgnoreMissingOnRevoke.IsNull() {
+		out.IgnoreMissingOnRevoke = cfg.IgnoreMissingOnRevoke.ValueBool()
+	}
+	if !cfg.MetadataViewScope.IsNull() {
+		out.MetadataViewScope = strings.ToUpper(cfg.MetadataViewScope.ValueString())
+	}
+	if out.Host != "" {
+		hosts := splitHosts(out.Host)
+		if slices.Contains(hosts, "") {
+			diags.AddAttributeError(path.Root("host"), "Invalid host",
+				"host must be a host name or IP address, or a comma-separated list of them without empty entries.")
+		}
+		out.Host = strings.Join(hosts, ",")
+	}
+	if out.User == "" {
+		diags.AddAttributeError(path.Root("user"), "Missing user", "Set user or the EXASOL_USER environment variable.")
+	}
+	if out.Password == "" {
+		diags.AddAttributeError(path.Root("password"), "Missing password", "Set password or the EXASOL_PASSWORD environment variable.")
+	}
+	switch {
+	case out.Host == "" && out.DatabaseID == "":
+		diags.AddAttributeError(path.Root("host"), "Missing host",
+			"Set host or the EXASOL_HOST environment variable, or database_id for Exasol SaaS.")
+	case out.Host != "" && out.DatabaseID != "":
+		diags.AddAttributeError(path.Root("database_id"), "Conflicting connection settings",
+			"host and database_id are mutually exclusive: database_id selects the SaaS host.")
+	case out.DatabaseID != "" && !isPersonalAccessToken(out.Password):
+		diags.AddAttributeError(path.Root("database_id"), "SaaS requires a personal access token",
+			"Exasol SaaS only accepts personal access tokens: set password to a token starting with exa_pat_.")
+	}
+	// openssl prints fingerprints as colon-separated hex; the driver compares plain hex
+	out.CertificateFingerprint = strings.ReplaceAll(strings.TrimSpace(cfg.CertificateFingerprint.ValueString()), ":", "")
+	switch {
+	case out.CertificateFingerprint == "":
+	case !certificateFingerprintPattern.MatchString(out.CertificateFingerprint):
+		diags.AddAttributeError(path.Root("certificate_fingerprint"), "Invalid certificate_fingerprint",
+			"certificate_fingerprint must be the SHA-256 fingerprint of the server certificate as 64 hex digits, "+
+				"optionally separated by colons.")
+	case !out.ValidateServerCertificate:
+		diags.AddAttributeError(path.Root("certificate_fingerprint"), "Conflicting TLS settings",
+			"certificate_fingerprint validates the server certificate against a known fingerprint and cannot be "+
+				"combined with validate_server_certificate = false. Remove validate_server_certificate to pin the certificate.")
+	}
+	if !cfg.OnReadPermissionError.IsNull() {
+		out.OnReadPermissionError = strings.ToLower(cfg.OnReadPermissionError.ValueString())
+	}
+	switch out.MetadataViewScope {
+	case resources.ViewScopeDBA, resources.ViewScopeAll, resources.ViewScopeUser:
+	default:
+		diags.AddAttributeError(path.Root("metadata_view_scope"), "Invalid metadata_view_scope",
+			fmt.Sprintf("metadata_view_scope must be DBA, ALL or USER, got %q.", out.MetadataViewScope))
+	}
+	switch out.OnReadPermissionError {
+	case resources.ReadPermissionErrorFail, resources.ReadPermissionErrorWarnKeep:
+	default:
+		diags.AddAttributeError(path.Root("on_read_permission_error"), "Invalid on_read_permission_error",
+			fmt.Sprintf("on_read_permission_error must be fail or warn_keep, got %q.", out.OnReadPermissionError))
+	}
+	if out.ValidationQuery != "" && !isSelectQuery(out.ValidationQuery) {
+		diags.AddAttributeError(path.Root("validation_query"), "Invalid validation_query",
+			"validation_query must be a single SELECT statement, e.g. SELECT 1.")
+	}
+	if out.ConnectRetries < 0 {
+		diags.AddAttributeError(path.Root("connect_retries"), "Invalid connect_retries",
+			"connect_retries must not be negative.")
+	}
+	if out.MaxConcurrentOperations < 0 {
+		diags.AddAttributeError(path.Root("max_concurrent_operations"), "Invalid max_concurrent_operations",
+			"max_concurrent_operations must not be negative; 0 means unlimited.")
+	}
+	if out.CollisionRetries < 0 {
+		diags.AddAttributeError(path.Root("collision_retries"), "Invalid collision_retries",
+			"collision_retries must not be negative.")
+	}
+	if out.ConnectRetryDelaySeconds < 0 {
+		diags.AddAttributeError(path.Root("connect_retry_delay_seconds"), "Invalid connect_retry_delay_seconds",
+			"connect_retry_delay_seconds must not be negative.")
+	}
+	for _, t := range []struct {
+		name  string
+		value int64
+	}{
+		{"connect_timeout_seconds", out.ConnectTimeoutSeconds},
+		{"query_timeout_seconds", out.QueryTimeoutSeconds},
+		{"read_timeout_seconds", out.ReadTimeoutSeconds},
+		{"delete_timeout_seconds", out.DeleteTimeoutSeconds},
+	} {
+		if t.value < 0 {
+			diags.AddAttributeError(path.Root(t.name), "Invalid "+t.name, t.name+" must not be negative.")
+		}
+	}
 
 	return out, diags
 }
+
+// isSelectQuery reports whether query is a single SELECT statement. A trailing semicolon is
+// tolerated; anything after it is not.
+func isSelectQuery(query string) bool {
+	query = strings.TrimSuffix(strings.TrimSpace(query), ";")
+	fields := strings.Fields(query)
+	return len(fields) > 0 && strings.EqualFold(fields[0], "SELECT") && !strings.Contains(query, ";")
+}
+
+// certificateFingerprintPattern matches a hex SHA-256 fingerprint after colons are removed.
+var certificateFingerprintPattern = regexp.MustCompile(`^[0-9A-Fa-f]{64}$`)
+
+// splitHosts splits a comma-separated host list and trims each entry. Empty entries are kept so
+// that the caller can reject them.
+func splitHosts(hosts string) []string {
+	parts := strings.Split(hosts, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
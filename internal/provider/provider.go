@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-exasol/internal/resources"
 )
@@ -36,26 +37,130 @@ func (p *ExasolProvider) Schema(
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required:    true,
-				Description: "Exasol host (DNS or IP).",
+				Optional: true,
+				Description: "Exasol host (DNS or IP), or a comma-separated list of the cluster's nodes to connect to " +
+					"any available one. Defaults to the EXASOL_HOST environment variable. Required unless database_id is set.",
+			},
+			"database_id": schema.StringAttribute{
+				Optional: true,
+				Description: "Exasol SaaS database ID. Connects to <database_id>." + saasHostDomain + " instead of host; " +
+					"requires a personal access token (exa_pat_...) as password.",
 			},
 			"port": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Exasol port. Default 8563.",
 			},
 			"user": schema.StringAttribute{
-				Required:    true,
-				Description: "Exasol username.",
+				Optional:    true,
+				Description: "Exasol username. Defaults to the EXASOL_USER environment variable.",
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Exasol password.",
+				Description: "Exasol password. Defaults to the EXASOL_PASSWORD environment variable.",
 			},
 			"validate_server_certificate": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Validate server TLS certificate. Default true.",
 			},
+			"certificate_fingerprint": schema.StringAttribute{
+				Optional: true,
+				Description: "SHA-256 fingerprint of the server TLS certificate (hex, colons allowed). The connection " +
+					"is accepted only if the certificate matches, which allows self-signed certificates without " +
+					"turning off validate_server_certificate. Cannot be combined with validate_server_certificate = false.",
+			},
+			"quote_identifiers": schema.BoolAttribute{
+				Optional: true,
+				Description: "Wrap identifiers in double quotes. Default true. Schema, virtual schema and object names keep " +
+					"their spelling; user, role, connection, consumer group and script names are uppercased before quoting. " +
+					"When false, identifiers are emitted unquoted and Exasol folds them to uppercase; " +
+					"names that are not regular identifiers (letters, digits, underscores) are rejected.",
+			},
+			"connect_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times to retry the initial connection if it fails. Default 0.",
+			},
+			"connect_retry_delay_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Delay before the first connection retry; doubled after each attempt. Default 5.",
+			},
+			"connect_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout for opening and pinging each connection attempt. 0 disables the limit. Default 30.",
+			},
+			"query_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout for each resource create, update or delete (DDL and DCL statements). 0 disables the limit. Default 0.",
+			},
+			"read_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout for each resource read against the system views. 0 disables the limit. Default 0.",
+			},
+			"delete_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Timeout for each resource delete, including collision retries. It bounds how long " +
+					"a stuck REVOKE or DROP can hold up a destroy. 0 disables the limit. Defaults to query_timeout_seconds.",
+			},
+			"collision_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "How often a GRANT, REVOKE or DROP that lost a transaction collision (SQL error 40001) is retried, " +
+					"with exponential backoff and jitter starting at 100ms. Default 5.",
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of resource creates, updates and deletes the provider runs at once, " +
+					"regardless of Terraform's -parallelism. Reads are not limited. 0 means unlimited. Default 0.",
+			},
+			"default_schema_cascade": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether exasol_schema drops schemas with CASCADE when the resource does not set cascade. " +
+					"Set to false to default to RESTRICT. Default true.",
+			},
+			"ignore_missing_on_revoke": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether destroying a grant resource succeeds when the REVOKE fails because the grant, its grantee " +
+					"or its object no longer exists. Set to false to fail instead, which surfaces state that no longer " +
+					"matches the database. Default true.",
+			},
+			"active_roles": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Roles the provider user must hold. Exasol has no SET ROLE: every granted role is always active, " +
+					"so these roles are only checked at configure time and the provider fails early if one is not granted.",
+			},
+			"sql_audit_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Append every executed DDL/DCL statement to this file with a UTC timestamp, the resource type " +
+					"and the outcome. Passwords are redacted.",
+			},
+			"statement_tag": schema.BoolAttribute{
+				Optional: true,
+				Description: "Prefix every executed DDL/DCL statement with a comment naming the issuing resource type, " +
+					"e.g. /* terraform: exasol_role */, so provider statements can be found in EXA_SQL_LAST_DAY and " +
+					"the auditing views. Default false.",
+			},
+			"on_read_permission_error": schema.StringAttribute{
+				Optional: true,
+				Description: "What a refresh does when the provider user is denied access to a system view: fail (default) " +
+					"fails the read, warn_keep logs a warning and keeps the existing state of the resource.",
+			},
+			"validation_query": schema.StringAttribute{
+				Optional: true,
+				Description: "SELECT statement used as the liveness check when the provider connects and whenever the " +
+					"connection is pinged, e.g. SELECT 1. Use it where the driver ping is not reliable, for example " +
+					"behind a proxy. Default: the driver ping.",
+			},
+			"session_profiling": schema.BoolAttribute{
+				Optional: true,
+				Description: "Switch on profiling (ALTER SESSION SET PROFILE = 'ON') for every session the provider opens, " +
+					"so its statements can be analysed in EXA_USER_PROFILE_LAST_DAY or with the exasol_session_profile " +
+					"data source. Default false.",
+			},
+			"metadata_view_scope": schema.StringAttribute{
+				Optional: true,
+				Description: "System views used to reconcile state: DBA (EXA_DBA_*, default), ALL (EXA_ALL_*) or USER (EXA_USER_*). " +
+					"ALL and USER allow a non-DBA user to manage roles and object privileges it owns or was granted.",
+			},
 		},
 	}
 }
@@ -72,6 +177,7 @@ func (p *ExasolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		resp.Diagnostics.AddError("Unable to create client", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(missingSystemViewWarnings(client.SystemViews, client.MetadataViewScope)...)
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -80,16 +186,30 @@ func (p *ExasolProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		resources.NewConnectionResource,
 		resources.NewConnectionGrantResource,
+		resources.NewConsumerGroupResource,
+		resources.NewDefaultConsumerGroupResource,
 		resources.NewGrantResource, // Legacy - use specific grant resources instead
 		resources.NewObjectPrivilegeResource,
+		resources.NewRoleAssignmentsResource,
 		resources.NewRoleGrantResource,
 		resources.NewRoleResource,
 		resources.NewSchemaResource,
+		resources.NewScriptResource,
 		resources.NewSystemPrivilegeResource,
 		resources.NewUserResource,
+		resources.NewUsersResource,
+		resources.NewVirtualSchemaResource,
 	}
 }
 
 func (p *ExasolProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		resources.NewGrantSQLDataSource,
+		resources.NewGrantValidationDataSource,
+		resources.NewObjectSizeDataSource,
+		resources.NewRolesDataSource,
+		resources.NewSchemasDataSource,
+		resources.NewSessionProfileDataSource,
+		resources.NewUserDataSource,
+	}
 }
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+	"terraform-provider-exasol/internal/resources"
+)
+
+// sqlAuditLog appends executed statements to a file. Terraform runs resource operations
+// concurrently, so writes are serialized and each entry is written with a single call.
+type sqlAuditLog struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func openSQLAuditLog(path string) (*sqlAuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open sql_audit_file: %w", err)
+	}
+	return &sqlAuditLog{f: f}, nil
+}
+
+// Close closes the audit file. It is a no-op on a nil log, so error paths can call it
+// whether or not sql_audit_file is set.
+func (l *sqlAuditLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.f.Close()
+}
+
+// record writes one tab-separated line: UTC timestamp, resource type, status and the
+// statement with secrets redacted and newlines flattened.
+func (l *sqlAuditLog) record(ctx context.Context, query string, err error) {
+	resource := exasolclient.AuditResource(ctx)
+	if resource == "" {
+		resource = "-"
+	}
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	stmt := strings.Join(strings.Fields(resources.SanitizeSQL(query)), " ")
+	line := fmt.Sprintf("%s\t%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339Nano), resource, status, stmt)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.f.WriteString(line)
+}
+
+// auditConnector wraps the Exasol connector so that every statement executed through
+// database/sql ExecContext is recorded in the audit log (if configured) and, sanitized, in the
+// context's statement recorder for last_applied_sql. Read-only queries are not recorded.
+// With tag, executed statements are sent with a statementTag prefix; the audit log and the
+// recorder keep the untagged statement. A validationQuery replaces the driver ping. With
+// profile, every new session has profiling switched on before it is handed out.
+type auditConnector struct {
+	driver.Connector
+	audit           *sqlAuditLog
+	tag             bool
+	validationQuery string
+	profile         bool
+}
+
+// enableProfilingSQL switches on profiling for the session it runs in.
+const enableProfilingSQL = `ALTER SESSION SET PROFILE = 'ON'`
+
+func (c *auditConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.profile {
+		execer, ok := conn.(driver.ExecerContext)
+		if !ok {
+			conn.Close()
+			return nil, fmt.Errorf("session_profiling: driver connection cannot execute statements")
+		}
+		if _, err := execer.ExecContext(ctx, enableProfilingSQL, nil); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("enable session profiling: %w", err)
+		}
+	}
+	return &auditConn{Conn: conn, audit: c.audit, tag: c.tag, validationQuery: c.validationQuery}, nil
+}
+
+// auditConn forwards the optional driver interfaces (ExecerContext, QueryerContext, Pinger,
+// ConnPrepareContext, ConnBeginTx, SessionResetter, Validator, NamedValueChecker) to the wrapped
+// connection, so wrapping it does not change how database/sql talks to the driver.
+type auditConn struct {
+	driver.Conn
+	audit           *sqlAuditLog
+	tag             bool
+	validationQuery string
+}
+
+// Ping implements driver.Pinger, which db.PingContext uses as the liveness check. With
+// validation_query set the query is run instead of the driver ping.
+func (c *auditConn) Ping(ctx context.Context) error {
+	if c.validationQuery == "" {
+		if pinger, ok := c.Conn.(driver.Pinger); ok {
+			return pinger.Ping(ctx)
+		}
+		return nil
+	}
+	rows, err := c.QueryContext(ctx, c.validationQuery, nil)
+	if err != nil {
+		return fmt.Errorf("validation query failed: %w", err)
+	}
+	return rows.Close()
+}
+
+func (c *auditConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	stmt := query
+	if c.tag {
+		stmt = statementTag(ctx) + query
+	}
+	res, err := execer.ExecContext(ctx, stmt, args)
+	if c.audit != nil {
+		c.audit.record(ctx, query, err)
+	}
+	if err == nil {
+		exasolclient.RecordStatement(ctx, resources.SanitizeSQL(query))
+	}
+	return res, err
+}
+
+func (c *auditConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *auditConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+// BeginTx implements driver.ConnBeginTx so transaction options reach the driver.
+func (c *auditConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	if opts.Isolation != driver.IsolationLevel(0) || opts.ReadOnly {
+		return nil, fmt.Errorf("driver does not support transaction options")
+	}
+	return c.Conn.Begin()
+}
+
+// ResetSession implements driver.SessionResetter, so pooled sessions are reset as the driver expects.
+func (c *auditConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+// IsValid implements driver.Validator, so database/sql drops sessions the driver reports as broken.
+func (c *auditConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+// CheckNamedValue implements driver.NamedValueChecker, so the driver's own argument conversion applies.
+func (c *auditConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// statementTag returns the comment prepended to executed statements when statement_tag is on,
+// e.g. "/* terraform: exasol_role */ ". Terraform does not pass the resource address to
+// providers, so the resource type is the most specific label available. Only letters, digits
+// and underscores of the label are kept, so it can neither close the comment nor carry data
+// from the statement itself.
+func statementTag(ctx context.Context) string {
+	label := strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, exasolclient.AuditResource(ctx))
+	if label == "" {
+		return "/* terraform */ "
+	}
+	return "/* terraform: " + label + " */ "
+}
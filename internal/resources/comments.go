@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// commentDescription is shared by the comment attributes of user, role, schema and connection.
+const commentDescription = "Comment on the object. Leave unset to not manage the comment; " +
+	`set to "" to clear it.`
+
+// buildCommentSQL renders COMMENT ON for an already quoted identifier.
+func buildCommentSQL(objectType, ident, comment string) string {
+	return fmt.Sprintf(`COMMENT ON %s %s IS '%s'`, objectType, ident, escapeStringLiteral(comment))
+}
+
+// commentChanged reports whether the planned comment has to be applied. A null plan means the
+// comment is unmanaged and is left alone; an empty string is a managed, empty comment.
+func commentChanged(plan, prior types.String) bool {
+	if plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	return prior.IsNull() || prior.ValueString() != plan.ValueString()
+}
+
+// applyComment runs COMMENT ON when commentChanged says so. Pass a null prior on create.
+func applyComment(ctx context.Context, db *sql.DB, objectType, ident string, plan, prior types.String) error {
+	if !commentChanged(plan, prior) {
+		return nil
+	}
+	stmt := buildCommentSQL(objectType, ident, plan.ValueString())
+	tflog.Info(ctx, "Setting comment", map[string]any{"sql": stmt})
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+// reconcileComment maps a *_COMMENT column back into state. Unmanaged (null) comments stay null.
+// Exasol stores an empty string as NULL, so a NULL column is reported as "" for a managed comment,
+// which matches a configured empty comment and shows a diff for any other value.
+func reconcileComment(configured types.String, actual sql.NullString) types.String {
+	if configured.IsNull() {
+		return types.StringNull()
+	}
+	if !actual.Valid {
+		return types.StringValue("")
+	}
+	return types.StringValue(actual.String)
+}
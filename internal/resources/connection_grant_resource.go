@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
@@ -17,10 +18,19 @@ import (
 
 var _ resource.Resource = &ConnectionGrantResource{}
 var _ resource.ResourceWithImportState = &ConnectionGrantResource{}
+var _ resource.ResourceWithValidateConfig = &ConnectionGrantResource{}
 
 // ConnectionGrantResource manages GRANT CONNECTION ... TO ... statements.
 type ConnectionGrantResource struct {
-	db *sql.DB
+	db                    *sql.DB
+	quoteIdentifiers      bool
+	queryTimeout          time.Duration
+	readTimeout           time.Duration
+	deleteTimeout         time.Duration
+	collisionRetries      int
+	ignoreMissingOnRevoke bool
+	readPermissionPolicy  string
+	operations            *exasolclient.OperationLimiter
 }
 
 func NewConnectionGrantResource() resource.Resource {
@@ -45,9 +55,35 @@ func (r *ConnectionGrantResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 				Description: "User or role name that receives connection access.",
 			},
+			"grantee_type": schema.StringAttribute{
+				Optional: true,
+				Description: "USER or ROLE. When set, the grantee is checked against EXA_DBA_USERS or EXA_DBA_ROLES " +
+					"before granting. Set ROLE when connection access is handed out through a role that is then " +
+					"granted to users with exasol_role_grant.",
+			},
+			"privilege": schema.StringAttribute{
+				Optional: true,
+				Description: "CONNECTION (default) grants the connection itself (GRANT CONNECTION), so the grantee can use it " +
+					"in IMPORT/EXPORT. ACCESS grants ACCESS ON CONNECTION, which lets UDF scripts read the connection's " +
+					"credentials. ACCESS is an object privilege and cannot be granted with admin option.",
+			},
+			"with_admin_option": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Grant the connection WITH ADMIN OPTION, allowing the grantee to grant it to others.",
+			},
+			"resolved_connection_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Connection name as stored in Exasol.",
+			},
+			"resolved_grantee": schema.StringAttribute{
+				Computed:    true,
+				Description: "Grantee name as stored in Exasol.",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
-				Description: "Terraform ID in format: CONNECTION_NAME|GRANTEE",
+				Description: "Terraform ID in format: CONNECTION_NAME|GRANTEE, with |ACCESS appended for privilege ACCESS",
 			},
 		},
 	}
@@ -59,16 +95,94 @@ func (r *ConnectionGrantResource) Configure(_ context.Context, req resource.Conf
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.ignoreMissingOnRevoke = c.IgnoreMissingOnRevoke
+		r.operations = c.Operations
 	}
 }
 
 type connectionGrantModel struct {
-	ID             types.String `tfsdk:"id"`
-	ConnectionName types.String `tfsdk:"connection_name"`
-	Grantee        types.String `tfsdk:"grantee"`
+	ID                     types.String `tfsdk:"id"`
+	ConnectionName         types.String `tfsdk:"connection_name"`
+	Grantee                types.String `tfsdk:"grantee"`
+	GranteeType            types.String `tfsdk:"grantee_type"`
+	Privilege              types.String `tfsdk:"privilege"`
+	ResolvedConnectionName types.String `tfsdk:"resolved_connection_name"`
+	WithAdminOption        types.Bool   `tfsdk:"with_admin_option"`
+	ResolvedGrantee        types.String `tfsdk:"resolved_grantee"`
+	Protected              types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL         types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *ConnectionGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg connectionGrantModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rejectPublicAdminOption(&resp.Diagnostics, path.Root("grantee"), cfg.Grantee, cfg.WithAdminOption)
+	if !cfg.Privilege.IsNull() && !cfg.Privilege.IsUnknown() {
+		switch connectionGrantPrivilege(cfg) {
+		case connectionPrivilegeConnection:
+		case connectionPrivilegeAccess:
+			if cfg.WithAdminOption.ValueBool() {
+				resp.Diagnostics.AddAttributeError(path.Root("with_admin_option"), "Admin option not supported",
+					"ACCESS ON CONNECTION is an object privilege and cannot be granted WITH ADMIN OPTION.")
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("privilege"), "Invalid privilege",
+				fmt.Sprintf("privilege must be CONNECTION or ACCESS, got %q.", cfg.Privilege.ValueString()))
+		}
+	}
+	if !cfg.GranteeType.IsNull() && !cfg.GranteeType.IsUnknown() {
+		switch strings.ToUpper(cfg.GranteeType.ValueString()) {
+		case "USER", "ROLE":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("grantee_type"), "Invalid grantee_type",
+				fmt.Sprintf("grantee_type must be USER or ROLE, got %q.", cfg.GranteeType.ValueString()))
+		}
+	}
+}
+
+// checkGranteeType verifies that grantee is a user or a role as declared by grantee_type.
+// Users and roles share one namespace, so a wrong type means the connection would reach a
+// different principal than intended, e.g. a single user instead of every member of a role.
+func (r *ConnectionGrantResource) checkGranteeType(ctx context.Context, granteeType types.String, grantee string) (string, error) {
+	if granteeType.IsNull() || granteeType.IsUnknown() {
+		return "", nil
+	}
+	query := `SELECT COUNT(*) FROM EXA_DBA_USERS WHERE USER_NAME = ?`
+	kind := strings.ToUpper(granteeType.ValueString())
+	if kind == "ROLE" {
+		query = `SELECT COUNT(*) FROM EXA_DBA_ROLES WHERE ROLE_NAME = ?`
+	}
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, grantee).Scan(&count); err != nil {
+		return "", err
+	}
+	if count == 0 {
+		return fmt.Sprintf("grantee_type is %s, but %q is not a %s. Create it first or correct grantee_type.",
+			kind, grantee, strings.ToLower(kind)), nil
+	}
+	return "", nil
 }
 
 func (r *ConnectionGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan connectionGrantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -94,19 +208,43 @@ func (r *ConnectionGrantResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// GRANT CONNECTION connection_name TO grantee
-	sqlStmt := fmt.Sprintf(`GRANT CONNECTION "%s" TO "%s"`, connection, grantee)
+	problem, err := r.checkGranteeType(ctx, plan.GranteeType, grantee)
+	if err != nil {
+		resp.Diagnostics.AddError("Check grantee failed", err.Error())
+		return
+	}
+	if problem != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("grantee"), "Grantee type mismatch", problem)
+		return
+	}
+
+	// GRANT CONNECTION connection_name TO grantee [WITH ADMIN OPTION]
+	// or GRANT ACCESS ON CONNECTION connection_name TO grantee
+	privilege := connectionGrantPrivilege(plan)
+	sqlStmt, err := buildConnectionGrantSQL(privilege, connection, grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection grant", err.Error())
+		return
+	}
+	if plan.WithAdminOption.ValueBool() {
+		sqlStmt += " WITH ADMIN OPTION"
+	}
 	tflog.Info(ctx, "Granting connection access", map[string]any{"sql": sqlStmt})
-	if _, err := r.db.ExecContext(ctx, sqlStmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, sqlStmt); err != nil {
 		resp.Diagnostics.AddError("GRANT CONNECTION failed", err.Error())
 		return
 	}
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s|%s", connection, grantee))
+	plan.ID = types.StringValue(connectionGrantID(privilege, connection, grantee))
+	setConnectionGrantResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ConnectionGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	var state connectionGrantModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -121,28 +259,56 @@ func (r *ConnectionGrantResource) Read(ctx context.Context, req resource.ReadReq
 	grantee := strings.ToUpper(state.Grantee.ValueString())
 
 	// Check if the grant exists in EXA_DBA_CONNECTION_PRIVS
-	// Connection grants are tracked separately in the connection privileges view
-	query := `SELECT 1 FROM EXA_DBA_CONNECTION_PRIVS WHERE GRANTED_CONNECTION = ? AND GRANTEE = ?`
-	var dummy int
-	err := r.db.QueryRowContext(ctx, query, connection, grantee).Scan(&dummy)
-	if err == sql.ErrNoRows {
-		// Grant doesn't exist, remove from state
-		resp.State.RemoveResource(ctx)
+	// Connection grants are tracked separately in the connection privileges view.
+	// The same connection can reach a grantee through more than one row, so look at
+	// every row instead of scanning a single one.
+	privilege := connectionGrantPrivilege(state)
+	var found, adminOption bool
+	err := retryRead(ctx, func() error {
+		var err error
+		found, adminOption, err = readConnectionGrant(ctx, r.db, privilege, connection, grantee)
+		return err
+	})
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read connection grant failed", err)
 		return
 	}
-	if err != nil {
-		resp.Diagnostics.AddError("Read connection grant failed", err.Error())
+	if !found {
+		// Grant doesn't exist (revoked outside Terraform, or the grantee or connection was
+		// dropped, which removes its grants), remove from state
+		tflog.Info(ctx, "Connection grant not found, removing from state", map[string]any{
+			"connection": connection,
+			"grantee":    grantee,
+		})
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	// Update state with normalized names
-	state.ConnectionName = types.StringValue(connection)
-	state.Grantee = types.StringValue(grantee)
-	state.ID = types.StringValue(fmt.Sprintf("%s|%s", connection, grantee))
+	// Keep the configured spelling of the names; id and resolved_grantee carry the stored form
+	state.ConnectionName = reconcileName(state.ConnectionName, connection, strings.ToUpper)
+	state.Grantee = reconcileName(state.Grantee, grantee, strings.ToUpper)
+	state.ID = types.StringValue(connectionGrantID(privilege, connection, grantee))
+	// Same convention as exasol_role_grant: true when granted with ADMIN OPTION, otherwise null
+	if adminOption {
+		state.WithAdminOption = types.BoolValue(true)
+	} else {
+		state.WithAdminOption = types.BoolNull()
+	}
+	setConnectionGrantResolved(&state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ConnectionGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state connectionGrantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -165,39 +331,75 @@ func (r *ConnectionGrantResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// If either changed, revoke old grant and create new one
-	if oldConnection != newConnection || oldGrantee != newGrantee {
+	// If either changed, or the admin option flipped, revoke the old grant and create the new one.
+	// REVOKE drops the admin option together with the grant, so a flip needs both statements.
+	oldPrivilege := connectionGrantPrivilege(state)
+	newPrivilege := connectionGrantPrivilege(plan)
+	if oldConnection != newConnection || oldGrantee != newGrantee || oldPrivilege != newPrivilege ||
+		plan.WithAdminOption.ValueBool() != state.WithAdminOption.ValueBool() {
+		problem, err := r.checkGranteeType(ctx, plan.GranteeType, newGrantee)
+		if err != nil {
+			resp.Diagnostics.AddError("Check grantee failed", err.Error())
+			return
+		}
+		if problem != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("grantee"), "Grantee type mismatch", problem)
+			return
+		}
+
 		// Revoke old grant
-		revokeStmt := fmt.Sprintf(`REVOKE CONNECTION "%s" FROM "%s"`, oldConnection, oldGrantee)
+		revokeStmt, err := buildConnectionRevokeSQL(oldPrivilege, oldConnection, oldGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid connection grant", err.Error())
+			return
+		}
 		tflog.Info(ctx, "Revoking old connection grant", map[string]any{"sql": revokeStmt})
-		if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 			resp.Diagnostics.AddError("REVOKE CONNECTION failed", err.Error())
 			return
 		}
 
 		// Grant new
-		grantStmt := fmt.Sprintf(`GRANT CONNECTION "%s" TO "%s"`, newConnection, newGrantee)
+		grantStmt, err := buildConnectionGrantSQL(newPrivilege, newConnection, newGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid connection grant", err.Error())
+			return
+		}
+		if plan.WithAdminOption.ValueBool() {
+			grantStmt += " WITH ADMIN OPTION"
+		}
 		tflog.Info(ctx, "Granting new connection access", map[string]any{"sql": grantStmt})
-		if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 			resp.Diagnostics.AddError("GRANT CONNECTION failed", err.Error())
 			return
 		}
 	}
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s|%s", newConnection, newGrantee))
+	plan.ID = types.StringValue(connectionGrantID(newPrivilege, newConnection, newGrantee))
+	setConnectionGrantResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ConnectionGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection_grant")
 
 	var state connectionGrantModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDelete(state.Protected, "exasol_connection_grant "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -213,26 +415,138 @@ func (r *ConnectionGrantResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// REVOKE CONNECTION connection_name FROM grantee
-	sqlStmt := fmt.Sprintf(`REVOKE CONNECTION "%s" FROM "%s"`, connection, grantee)
+	sqlStmt, err := buildConnectionRevokeSQL(connectionGrantPrivilege(state), connection, grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection grant", err.Error())
+		return
+	}
 	tflog.Info(ctx, "Revoking connection access", map[string]any{"sql": sqlStmt})
-	if _, err := r.db.ExecContext(ctx, sqlStmt); err != nil {
+	if err := execRevoke(ctx, r.db, r.collisionRetries, sqlStmt, r.ignoreMissingOnRevoke); err != nil {
+		// Dropping a user or role takes its connection grants with it, so a REVOKE against a
+		// grantee that no longer exists has nothing left to do.
+		if !r.ignoreMissingOnRevoke {
+			resp.Diagnostics.AddError("REVOKE CONNECTION failed", err.Error())
+			return
+		}
+		if exists, checkErr := granteeExists(ctx, r.db, grantee); checkErr == nil && !exists {
+			tflog.Warn(ctx, "Grantee no longer exists, treating connection grant as revoked", map[string]any{
+				"connection": connection,
+				"grantee":    grantee,
+			})
+			return
+		}
 		resp.Diagnostics.AddError("REVOKE CONNECTION failed", err.Error())
 	}
 }
 
 func (r *ConnectionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: CONNECTION_NAME|GRANTEE
+	// Import format: CONNECTION_NAME|GRANTEE or CONNECTION_NAME|GRANTEE|ACCESS
 	parts := strings.Split(req.ID, "|")
-	if len(parts) != 2 {
+	if len(parts) == 3 && !strings.EqualFold(parts[2], connectionPrivilegeAccess) {
+		parts = nil
+	}
+	if len(parts) != 2 && len(parts) != 3 {
 		resp.Diagnostics.AddError("Invalid import ID",
-			`Expected format: "CONNECTION_NAME|GRANTEE"`)
+			`Expected format: "CONNECTION_NAME|GRANTEE" or "CONNECTION_NAME|GRANTEE|ACCESS"`)
 		return
 	}
 
 	connection := strings.ToUpper(parts[0])
 	grantee := strings.ToUpper(parts[1])
+	privilege := connectionPrivilegeConnection
+	if len(parts) == 3 {
+		privilege = connectionPrivilegeAccess
+		resp.State.SetAttribute(ctx, path.Root("privilege"), privilege)
+	}
 
 	resp.State.SetAttribute(ctx, path.Root("connection_name"), connection)
 	resp.State.SetAttribute(ctx, path.Root("grantee"), grantee)
-	resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s|%s", connection, grantee))
+	resp.State.SetAttribute(ctx, path.Root("id"), connectionGrantID(privilege, connection, grantee))
+}
+
+// Privileges exasol_connection_grant can grant on a connection.
+const (
+	connectionPrivilegeConnection = "CONNECTION"
+	connectionPrivilegeAccess     = "ACCESS"
+)
+
+// connectionGrantPrivilege returns the uppercased privilege attribute; unset means CONNECTION.
+func connectionGrantPrivilege(m connectionGrantModel) string {
+	if m.Privilege.IsNull() || m.Privilege.IsUnknown() {
+		return connectionPrivilegeConnection
+	}
+	return strings.ToUpper(strings.TrimSpace(m.Privilege.ValueString()))
+}
+
+// connectionGrantID keeps the CONNECTION|GRANTEE form of plain connection grants, so existing
+// state is unaffected, and appends the privilege for ACCESS.
+func connectionGrantID(privilege, connection, grantee string) string {
+	if privilege == connectionPrivilegeAccess {
+		return fmt.Sprintf("%s|%s|%s", connection, grantee, privilege)
+	}
+	return fmt.Sprintf("%s|%s", connection, grantee)
+}
+
+// connectionPrivilegeClause renders the privilege part of GRANT/REVOKE for a connection.
+func connectionPrivilegeClause(privilege, connIdent string) string {
+	if privilege == connectionPrivilegeAccess {
+		return "ACCESS ON CONNECTION " + connIdent
+	}
+	return "CONNECTION " + connIdent
+}
+
+func buildConnectionGrantSQL(privilege, connection, grantee string, quoted bool) (string, error) {
+	connIdent, err := quoteIdent(connection, quoted)
+	if err != nil {
+		return "", err
+	}
+	granteeIdent, err := quoteIdent(grantee, quoted)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`GRANT %s TO %s`, connectionPrivilegeClause(privilege, connIdent), granteeIdent), nil
+}
+
+func buildConnectionRevokeSQL(privilege, connection, grantee string, quoted bool) (string, error) {
+	connIdent, err := quoteIdent(connection, quoted)
+	if err != nil {
+		return "", err
+	}
+	granteeIdent, err := quoteIdent(grantee, quoted)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`REVOKE %s FROM %s`, connectionPrivilegeClause(privilege, connIdent), granteeIdent), nil
+}
+
+// setConnectionGrantResolved fills the computed resolved_* attributes from the normalized inputs.
+func setConnectionGrantResolved(m *connectionGrantModel) {
+	m.ResolvedConnectionName = resolvedName(m.ConnectionName)
+	m.ResolvedGrantee = resolvedName(m.Grantee)
+}
+
+// readConnectionGrant reports whether grantee holds the privilege on the connection and whether
+// any of the matching rows carries the admin option. GRANT CONNECTION is listed in
+// EXA_DBA_CONNECTION_PRIVS; ACCESS ON CONNECTION is an object privilege and is listed in
+// EXA_DBA_OBJ_PRIVS, which has no admin option.
+func readConnectionGrant(ctx context.Context, db *sql.DB, privilege, connection, grantee string) (found, adminOption bool, err error) {
+	query := `SELECT ADMIN_OPTION FROM EXA_DBA_CONNECTION_PRIVS WHERE GRANTED_CONNECTION = ? AND GRANTEE = ?`
+	if privilege == connectionPrivilegeAccess {
+		query = `SELECT NULL FROM EXA_DBA_OBJ_PRIVS
+			WHERE OBJECT_TYPE = 'CONNECTION' AND PRIVILEGE = 'ACCESS' AND OBJECT_NAME = ? AND GRANTEE = ?`
+	}
+	rows, err := db.QueryContext(ctx, query, connection, grantee)
+	if err != nil {
+		return false, false, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var v any
+		if err := rows.Scan(&v); err != nil {
+			return false, false, err
+		}
+		found = true
+		adminOption = adminOption || parseAdminOption(v)
+	}
+	return found, adminOption, rows.Err()
 }
@@ -4,24 +4,38 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ConnectionResource{}
 var _ resource.ResourceWithImportState = &ConnectionResource{}
+var _ resource.ResourceWithValidateConfig = &ConnectionResource{}
 
 // ConnectionResource manages Exasol database connections.
 // Connections are used for IMPORT/EXPORT and can connect to various external systems.
 type ConnectionResource struct {
-	db *sql.DB
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
 }
 
 func NewConnectionResource() resource.Resource {
@@ -42,6 +56,7 @@ func (r *ConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				Description: "Connection name. Case-insensitive in Exasol.",
 			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Terraform ID — set to the connection name in uppercase.",
@@ -52,13 +67,48 @@ func (r *ConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					"JDBC string, etc.). Multiple hosts can be separated by commas.",
 			},
 			"user": schema.StringAttribute{
-				Optional:    true,
-				Description: "Username for authentication.",
+				Optional: true,
+				Description: "Username for authentication. Must be set together with password; " +
+					"omit both for connections that authenticate without inline credentials (e.g. Kerberos keytabs).",
 			},
 			"password": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Password for authentication.",
+				Description: "Password for authentication. Must be set together with user.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: commentDescription,
+			},
+			"ignore_to_drift": schema.BoolAttribute{
+				Optional: true,
+				Description: "Do not read the connection string back from EXA_DBA_CONNECTIONS. By default a " +
+					"connection string changed outside Terraform shows up as drift on `to`; set this when the " +
+					"string is deliberately managed elsewhere. The password is never read back.",
+			},
+			"test_on_create": schema.BoolAttribute{
+				Optional: true,
+				Description: "After creating the connection, run a trivial IMPORT through it (SELECT 1 on the remote side) " +
+					"to catch wrong credentials early. Supported for Exasol (host:port) and JDBC connections; " +
+					"URL connections (S3, FTP, HTTP) are skipped. A failure is reported as a warning unless test_on_create_strict is set.",
+			},
+			"test_on_create_strict": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail the create when the test_on_create check fails. The connection is dropped again.",
+			},
+			"owner": schema.StringAttribute{
+				Computed:    true,
+				Description: "Connection owner as reported by EXA_DBA_CONNECTIONS.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp as reported by EXA_DBA_CONNECTIONS.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -70,18 +120,61 @@ func (r *ConnectionResource) Configure(_ context.Context, req resource.Configure
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
 	}
 }
 
 type connectionModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	To       types.String `tfsdk:"to"`
-	User     types.String `tfsdk:"user"`
-	Password types.String `tfsdk:"password"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	To             types.String `tfsdk:"to"`
+	User           types.String `tfsdk:"user"`
+	Password       types.String `tfsdk:"password"`
+	Comment        types.String `tfsdk:"comment"`
+	IgnoreToDrift  types.Bool   `tfsdk:"ignore_to_drift"`
+	TestOnCreate   types.Bool   `tfsdk:"test_on_create"`
+	TestStrict     types.Bool   `tfsdk:"test_on_create_strict"`
+	Owner          types.String `tfsdk:"owner"`
+	Created        types.String `tfsdk:"created"`
+	LastAppliedSQL types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *ConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg connectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.User.IsUnknown() || cfg.Password.IsUnknown() {
+		return
+	}
+
+	// Exasol only accepts credentials as a pair: USER '...' IDENTIFIED BY '...'
+	hasUser := !cfg.User.IsNull() && cfg.User.ValueString() != ""
+	hasPassword := !cfg.Password.IsNull() && cfg.Password.ValueString() != ""
+	if hasUser != hasPassword {
+		resp.Diagnostics.AddAttributeError(path.Root("user"), "Incomplete connection credentials",
+			"user and password must either both be set or both be omitted.")
+	}
 }
 
 func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan connectionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -101,7 +194,7 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	sqlStmt, err := buildCreateConnectionSQL(plan)
+	sqlStmt, err := buildCreateConnectionSQL(plan, r.quoteIdentifiers)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid connection configuration", err.Error())
 		return
@@ -112,13 +205,37 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("CREATE CONNECTION failed", err.Error())
 		return
 	}
+	conn, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection name", err.Error())
+		return
+	}
+	if err := applyComment(ctx, r.db, "CONNECTION", conn, plan.Comment, types.StringNull()); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON CONNECTION failed", err.Error())
+		return
+	}
+	if plan.TestOnCreate.ValueBool() && !r.testConnection(ctx, plan, conn, &resp.Diagnostics) {
+		// Strict mode: do not leave a connection behind that Terraform does not track
+		drop := fmt.Sprintf(`DROP CONNECTION %s`, conn)
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, drop); err != nil {
+			resp.Diagnostics.AddError("DROP CONNECTION after failed test failed", err.Error())
+		}
+		return
+	}
 
 	plan.ID = types.StringValue(upName)
-	plan.Name = types.StringValue(upName)
+	if _, err := readConnectionMetadata(ctx, r.db, &plan); err != nil {
+		resp.Diagnostics.AddError("Read connection failed", err.Error())
+		return
+	}
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -131,26 +248,42 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Query EXA_DBA_CONNECTIONS to check if connection exists
-	var dummy int
-	query := `SELECT 1 FROM EXA_DBA_CONNECTIONS WHERE CONNECTION_NAME = ?`
-	err := r.db.QueryRowContext(ctx, query, state.ID.ValueString()).Scan(&dummy)
+	var to sql.NullString
+	err := retryRead(ctx, func() error {
+		var err error
+		to, err = readConnectionMetadata(ctx, r.db, &state)
+		return err
+	})
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Read connection failed", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read connection failed", err)
 		return
 	}
 
-	// Note: We cannot read back the password or exact connection string for security reasons
-	// Exasol doesn't expose these values in system tables
-	// Keep the state as-is if the connection exists
-	state.ID = types.StringValue(strings.ToUpper(state.Name.ValueString()))
+	// The password cannot be read back; the connection string can, so an out-of-band ALTER
+	// CONNECTION shows up as drift and the next apply restores the configured target.
+	if to.Valid && !state.IgnoreToDrift.ValueBool() &&
+		normalizeConnectionTarget(to.String) != normalizeConnectionTarget(state.To.ValueString()) {
+		state.To = types.StringValue(redactConnectionTarget(to.String))
+	}
+	state.Name = reconcileName(state.Name, state.ID.ValueString(), strings.ToUpper)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state connectionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -162,7 +295,7 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	upOld := strings.ToUpper(state.Name.ValueString())
+	upOld := strings.ToUpper(state.ID.ValueString()) // ID always holds the stored name
 	upNew := strings.ToUpper(plan.Name.ValueString())
 
 	// Validate identifiers
@@ -173,20 +306,35 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// If name changed, we need to rename first
 	if upOld != upNew {
-		stmt := fmt.Sprintf(`RENAME CONNECTION "%s" TO "%s"`, upOld, upNew)
+		oldConn, err := quoteIdent(upOld, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid connection name", err.Error())
+			return
+		}
+		newConn, err := quoteIdent(upNew, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid connection name", err.Error())
+			return
+		}
+		stmt := fmt.Sprintf(`RENAME CONNECTION %s TO %s`, oldConn, newConn)
 		tflog.Info(ctx, "Renaming connection", map[string]any{"sql": stmt})
 		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
 			resp.Diagnostics.AddError("RENAME CONNECTION failed", err.Error())
 			return
 		}
+		// Save the rename before the ALTER: if the ALTER fails, the next apply must alter the
+		// renamed connection instead of renaming the old name again. Both keep the connection's grants.
+		state.ID = types.StringValue(upNew)
+		state.Name = plan.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	}
 
 	// Check if connection properties changed
-	if plan.To.ValueString() != state.To.ValueString() ||
+	if normalizeConnectionTarget(plan.To.ValueString()) != normalizeConnectionTarget(state.To.ValueString()) ||
 		plan.User.ValueString() != state.User.ValueString() ||
 		plan.Password.ValueString() != state.Password.ValueString() {
 
-		alter, err := buildAlterConnectionSQL(plan)
+		alter, err := buildAlterConnectionSQL(plan, r.quoteIdentifiers)
 		if err != nil {
 			resp.Diagnostics.AddError("Invalid alter connection config", err.Error())
 			return
@@ -198,15 +346,35 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
+	conn, err := quoteIdent(upNew, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection name", err.Error())
+		return
+	}
+	if err := applyComment(ctx, r.db, "CONNECTION", conn, plan.Comment, state.Comment); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON CONNECTION failed", err.Error())
+		return
+	}
+
 	plan.ID = types.StringValue(upNew)
-	plan.Name = types.StringValue(upNew)
+	if _, err := readConnectionMetadata(ctx, r.db, &plan); err != nil {
+		resp.Diagnostics.AddError("Read connection failed", err.Error())
+		return
+	}
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_connection")
 
 	var state connectionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -224,21 +392,109 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	stmt := fmt.Sprintf(`DROP CONNECTION "%s"`, upName)
+	conn, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid connection name", err.Error())
+		return
+	}
+
+	stmt := fmt.Sprintf(`DROP CONNECTION %s`, conn)
 	tflog.Info(ctx, "Dropping connection", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
 		resp.Diagnostics.AddError("DROP CONNECTION failed", err.Error())
 	}
 }
 
 func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Allow import by connection name
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by connection name; Read fills name from the stored spelling
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.ToUpper(req.ID))...)
 }
 
 // --- helpers -------------------------------------------------------
 
-func buildCreateConnectionSQL(m connectionModel) (string, error) {
+// readConnectionMetadata fills the computed owner/created attributes for the connection
+// identified by m.ID and returns its stored connection string. It returns sql.ErrNoRows if the
+// connection does not exist.
+func readConnectionMetadata(ctx context.Context, db *sql.DB, m *connectionModel) (sql.NullString, error) {
+	var owner, created, comment, to sql.NullString
+	query := `SELECT CONNECTION_OWNER, CREATED, CONNECTION_COMMENT, CONNECTION_STRING FROM EXA_DBA_CONNECTIONS WHERE CONNECTION_NAME = ?`
+	if err := db.QueryRowContext(ctx, query, m.ID.ValueString()).Scan(&owner, &created, &comment, &to); err != nil {
+		return sql.NullString{}, err
+	}
+	m.Owner = nullableString(owner)
+	m.Created = nullableString(created)
+	m.Comment = reconcileComment(m.Comment, comment)
+	return to, nil
+}
+
+// connectionHostList matches an Exasol-style host[:port] list such as "exa1..3.example.com:8563".
+var connectionHostList = regexp.MustCompile(`^[A-Za-z0-9.\-]+(:\d+)?(,[A-Za-z0-9.\-]+(:\d+)?)*$`)
+
+// normalizeConnectionTarget returns the form of a connection string used to compare targets.
+// Surrounding whitespace is dropped, and for URL targets (S3, FTP, HTTP and jdbc:<driver>:// URLs)
+// credentials embedded as user:password@ are removed, the scheme and host are lowercased and a
+// trailing slash is ignored: the credentials are managed through user/password, and the rest does
+// not change the target. A plain host[:port] list is lowercased as a whole. Paths, bucket keys and
+// other strings keep their case, since they may be case-sensitive.
+func normalizeConnectionTarget(s string) string {
+	s = strings.TrimSpace(s)
+	prefix, rest := "", s
+	if len(s) > 5 && strings.EqualFold(s[:5], "jdbc:") {
+		prefix, rest = "jdbc:", s[5:]
+	}
+	if !strings.Contains(rest, "://") {
+		if prefix == "" && connectionHostList.MatchString(rest) {
+			return strings.ToLower(rest)
+		}
+		return prefix + rest
+	}
+	u, err := url.Parse(rest)
+	if err != nil || u.Host == "" {
+		return prefix + rest
+	}
+	u.User = nil
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return prefix + u.String()
+}
+
+// redactConnectionTarget removes credentials from a connection string read back from Exasol
+// before it is stored in the (non-sensitive) to attribute: the password of URL userinfo and the
+// values of query parameters that look like secrets are replaced.
+func redactConnectionTarget(s string) string {
+	prefix, rest := "", s
+	if len(s) > 5 && strings.EqualFold(s[:5], "jdbc:") {
+		prefix, rest = s[:5], s[5:]
+	}
+	if !strings.Contains(rest, "://") {
+		return s
+	}
+	u, err := url.Parse(rest)
+	if err != nil {
+		return s
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+	if u.RawQuery != "" {
+		q := u.Query()
+		for key := range q {
+			k := strings.ToLower(key)
+			if strings.Contains(k, "password") || strings.Contains(k, "secret") ||
+				strings.Contains(k, "token") || strings.Contains(k, "key") {
+				q.Set(key, redactedValue)
+			}
+		}
+		u.RawQuery = q.Encode()
+	}
+	return prefix + u.String()
+}
+
+// redactedValue replaces credentials in connection strings stored in state.
+const redactedValue = "***REDACTED***"
+
+func buildCreateConnectionSQL(m connectionModel, quoted bool) (string, error) {
 	upName := strings.ToUpper(m.Name.ValueString())
 
 	// Validate identifier
@@ -246,11 +502,16 @@ func buildCreateConnectionSQL(m connectionModel) (string, error) {
 		return "", fmt.Errorf("invalid connection name: contains illegal characters")
 	}
 
+	conn, err := quoteIdent(upName, quoted)
+	if err != nil {
+		return "", err
+	}
+
 	// Escape the connection string
 	escapedTo := escapeStringLiteral(m.To.ValueString())
 
 	var stmt strings.Builder
-	stmt.WriteString(fmt.Sprintf(`CREATE CONNECTION "%s" TO '%s'`, upName, escapedTo))
+	stmt.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO '%s'`, conn, escapedTo))
 
 	// Add credentials if provided
 	if !m.User.IsNull() && !m.User.IsUnknown() && m.User.ValueString() != "" {
@@ -266,7 +527,7 @@ func buildCreateConnectionSQL(m connectionModel) (string, error) {
 	return stmt.String(), nil
 }
 
-func buildAlterConnectionSQL(m connectionModel) (string, error) {
+func buildAlterConnectionSQL(m connectionModel, quoted bool) (string, error) {
 	upName := strings.ToUpper(m.Name.ValueString())
 
 	// Validate identifier
@@ -274,11 +535,16 @@ func buildAlterConnectionSQL(m connectionModel) (string, error) {
 		return "", fmt.Errorf("invalid connection name: contains illegal characters")
 	}
 
+	conn, err := quoteIdent(upName, quoted)
+	if err != nil {
+		return "", err
+	}
+
 	// Escape the connection string
 	escapedTo := escapeStringLiteral(m.To.ValueString())
 
 	var stmt strings.Builder
-	stmt.WriteString(fmt.Sprintf(`ALTER CONNECTION "%s" TO '%s'`, upName, escapedTo))
+	stmt.WriteString(fmt.Sprintf(`ALTER CONNECTION %s TO '%s'`, conn, escapedTo))
 
 	// Add credentials if provided
 	if !m.User.IsNull() && !m.User.IsUnknown() && m.User.ValueString() != "" {
@@ -293,3 +559,43 @@ func buildAlterConnectionSQL(m connectionModel) (string, error) {
 
 	return stmt.String(), nil
 }
+
+// connectionTestQuery returns a query that reaches the remote system through the connection and
+// returns one row. Only Exasol (host:port) and JDBC connections can be tested without knowing a
+// remote file, so URL connections (S3, FTP, HTTP, ...) report false.
+func connectionTestQuery(to, conn string) (string, bool) {
+	target := strings.ToLower(strings.TrimSpace(to))
+	switch {
+	case strings.HasPrefix(target, "jdbc:"):
+		return fmt.Sprintf(`SELECT * FROM (IMPORT FROM JDBC AT %s STATEMENT 'SELECT 1')`, conn), true
+	case strings.Contains(target, "://"):
+		return "", false
+	default:
+		return fmt.Sprintf(`SELECT * FROM (IMPORT FROM EXA AT %s STATEMENT 'SELECT 1')`, conn), true
+	}
+}
+
+// testConnection runs the test_on_create check. Failures are warnings unless
+// test_on_create_strict is set; it returns false only for a strict failure.
+func (r *ConnectionResource) testConnection(ctx context.Context, plan connectionModel, conn string, diags *diag.Diagnostics) bool {
+	query, ok := connectionTestQuery(plan.To.ValueString(), conn)
+	if !ok {
+		diags.AddAttributeWarning(path.Root("test_on_create"), "Connection not tested",
+			fmt.Sprintf("test_on_create cannot check URL connections like %q without a remote file; skipped.", plan.To.ValueString()))
+		return true
+	}
+	tflog.Info(ctx, "Testing connection", map[string]any{"sql": query})
+	var one any
+	err := r.db.QueryRowContext(ctx, query).Scan(&one)
+	if err == nil {
+		return true
+	}
+	msg := fmt.Sprintf("Connection %s could not reach the remote system: %s", conn, err.Error())
+	if plan.TestStrict.ValueBool() {
+		diags.AddAttributeError(path.Root("test_on_create"), "Connection test failed", msg)
+		return false
+	}
+	diags.AddAttributeWarning(path.Root("test_on_create"), "Connection test failed", msg+
+		" The connection was created anyway; set test_on_create_strict to fail instead.")
+	return true
+}
@@ -0,0 +1,465 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ConsumerGroupResource{}
+var _ resource.ResourceWithImportState = &ConsumerGroupResource{}
+var _ resource.ResourceWithValidateConfig = &ConsumerGroupResource{}
+
+// ConsumerGroupResource manages resource manager consumer groups (Exasol 7.0+).
+type ConsumerGroupResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+func NewConsumerGroupResource() resource.Resource { return &ConsumerGroupResource{} }
+
+func (r *ConsumerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consumer_group"
+}
+
+const ramLimitDescription = "Absolute size with an optional K, M, G or T unit (e.g. '10G'), a percentage " +
+	"(e.g. '25%'), or 'OFF' for no limit. Leave unset to not manage the limit."
+
+func (r *ConsumerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates, alters and drops an Exasol consumer group (resource manager). " +
+			"Consumer groups are stored in UPPERCASE inside Exasol, but the 'name' attribute " +
+			"preserves the exact spelling from the Terraform configuration.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Consumer group name (case preserved in Terraform). Changing it recreates the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(consumerGroupRenamed,
+						"Exasol cannot rename consumer groups.", "Exasol cannot rename consumer groups."),
+				},
+			},
+			"cpu_weight": schema.Int64Attribute{
+				Required:    true,
+				Description: "Share of CPU resources (1-1000) relative to the other consumer groups.",
+			},
+			"precedence": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Priority (1-1000) used when groups compete for resources. Leave unset to not manage it.",
+			},
+			"group_temp_db_ram_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "GROUP_TEMP_DB_RAM_LIMIT for all sessions of the group. " + ramLimitDescription,
+			},
+			"user_ram_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "USER_TEMP_DB_RAM_LIMIT for all sessions of one user in the group. " + ramLimitDescription,
+			},
+			"session_ram_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "SESSION_TEMP_DB_RAM_LIMIT for a single session in the group. " + ramLimitDescription,
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Consumer group name as stored in Exasol (always UPPERCASE).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// consumerGroupRenamed replaces the group only when the stored name changes; spelling-only
+// changes of 'name' are applied in place.
+func consumerGroupRenamed(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = upper(req.StateValue.ValueString()) != upper(req.PlanValue.ValueString())
+}
+
+func (r *ConsumerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type consumerGroupModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	CPUWeight           types.Int64  `tfsdk:"cpu_weight"`
+	Precedence          types.Int64  `tfsdk:"precedence"`
+	GroupTempDBRAMLimit types.String `tfsdk:"group_temp_db_ram_limit"`
+	UserRAMLimit        types.String `tfsdk:"user_ram_limit"`
+	SessionRAMLimit     types.String `tfsdk:"session_ram_limit"`
+	LastAppliedSQL      types.String `tfsdk:"last_applied_sql"`
+}
+
+// consumerGroupSetting pairs a consumer group option with its Terraform attribute.
+type consumerGroupSetting struct {
+	attr   string
+	option string
+	value  func(m *consumerGroupModel) *types.String
+}
+
+var consumerGroupRAMLimits = []consumerGroupSetting{
+	{"group_temp_db_ram_limit", "GROUP_TEMP_DB_RAM_LIMIT", func(m *consumerGroupModel) *types.String { return &m.GroupTempDBRAMLimit }},
+	{"user_ram_limit", "USER_TEMP_DB_RAM_LIMIT", func(m *consumerGroupModel) *types.String { return &m.UserRAMLimit }},
+	{"session_ram_limit", "SESSION_TEMP_DB_RAM_LIMIT", func(m *consumerGroupModel) *types.String { return &m.SessionRAMLimit }},
+}
+
+func (r *ConsumerGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg consumerGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for attr, v := range map[string]types.Int64{"cpu_weight": cfg.CPUWeight, "precedence": cfg.Precedence} {
+		if !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < 1 || v.ValueInt64() > 1000) {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid "+attr,
+				fmt.Sprintf("%s must be between 1 and 1000, got %d.", attr, v.ValueInt64()))
+		}
+	}
+	for _, s := range consumerGroupRAMLimits {
+		v := *s.value(&cfg)
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if _, ok := normalizeRAMLimit(v.ValueString()); !ok {
+			resp.Diagnostics.AddAttributeError(path.Root(s.attr), "Invalid "+s.attr,
+				fmt.Sprintf("%q is not a valid RAM limit. %s", v.ValueString(), ramLimitDescription))
+		}
+	}
+}
+
+func (r *ConsumerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_consumer_group")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan consumerGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	upName := upper(plan.Name.ValueString())
+	if !isValidIdentifier(upName) {
+		resp.Diagnostics.AddError("Invalid consumer group name",
+			fmt.Sprintf("Consumer group name %q contains invalid characters.", plan.Name.ValueString()))
+		return
+	}
+	group, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid consumer group name", err.Error())
+		return
+	}
+
+	stmt := fmt.Sprintf(`CREATE CONSUMER GROUP %s WITH %s`, group,
+		strings.Join(consumerGroupOptions(&plan, nil), ", "))
+	tflog.Debug(ctx, "Creating consumer group", map[string]any{"sql": stmt})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		resp.Diagnostics.AddError("Error creating consumer group", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(upName)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConsumerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	var state consumerGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var current string
+	var cpuWeight, precedence sql.NullFloat64
+	limits := make([]any, len(consumerGroupRAMLimits))
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx, `SELECT CONSUMER_GROUP_NAME, CPU_WEIGHT, PRECEDENCE,
+			GROUP_TEMP_DB_RAM_LIMIT, USER_TEMP_DB_RAM_LIMIT, SESSION_TEMP_DB_RAM_LIMIT
+			FROM EXA_CONSUMER_GROUPS WHERE CONSUMER_GROUP_NAME = ?`, state.ID.ValueString()).
+			Scan(&current, &cpuWeight, &precedence, &limits[0], &limits[1], &limits[2])
+	})
+	if err == sql.ErrNoRows {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Error reading consumer group", err)
+		return
+	}
+
+	state.ID = types.StringValue(current)
+	state.Name = reconcileName(state.Name, current, upper)
+	state.CPUWeight = nullableInt64(cpuWeight)
+	if !state.Precedence.IsNull() {
+		state.Precedence = nullableInt64(precedence)
+	}
+	for i, s := range consumerGroupRAMLimits {
+		v := s.value(&state)
+		*v = reconcileRAMLimit(*v, limits[i])
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ConsumerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_consumer_group")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, prior consumerGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	upName := upper(prior.ID.ValueString())
+	if !isValidIdentifier(upName) {
+		resp.Diagnostics.AddError("Invalid consumer group name",
+			fmt.Sprintf("Consumer group name %q contains invalid characters.", prior.ID.ValueString()))
+		return
+	}
+	group, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid consumer group name", err.Error())
+		return
+	}
+
+	if changed := consumerGroupOptions(&plan, &prior); len(changed) > 0 {
+		stmt := fmt.Sprintf(`ALTER CONSUMER GROUP %s SET %s`, group, strings.Join(changed, ", "))
+		tflog.Debug(ctx, "Altering consumer group", map[string]any{"sql": stmt})
+		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+			resp.Diagnostics.AddError("Error altering consumer group", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(upName)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, prior.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConsumerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_consumer_group")
+
+	var state consumerGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	upName := upper(state.ID.ValueString())
+	if !isValidIdentifier(upName) {
+		resp.Diagnostics.AddError("Invalid consumer group name",
+			fmt.Sprintf("Consumer group name %q contains invalid characters.", state.ID.ValueString()))
+		return
+	}
+	group, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid consumer group name", err.Error())
+		return
+	}
+
+	stmt := fmt.Sprintf(`DROP CONSUMER GROUP %s`, group)
+	tflog.Debug(ctx, "Dropping consumer group", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		resp.Diagnostics.AddError("Error dropping consumer group", err.Error())
+	}
+}
+
+func (r *ConsumerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by group name; Read fills name, cpu_weight and the stored spelling.
+	// precedence and the RAM limits stay unmanaged until they are configured.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), upper(req.ID))...)
+}
+
+// consumerGroupOptions renders the option assignments of a consumer group. With a prior
+// state only options whose value changed are returned; unset (unmanaged) options are skipped.
+func consumerGroupOptions(plan, prior *consumerGroupModel) []string {
+	var opts []string
+	ints := []struct {
+		option      string
+		plan, prior types.Int64
+	}{
+		{"CPU_WEIGHT", plan.CPUWeight, types.Int64Null()},
+		{"PRECEDENCE", plan.Precedence, types.Int64Null()},
+	}
+	if prior != nil {
+		ints[0].prior, ints[1].prior = prior.CPUWeight, prior.Precedence
+	}
+	for _, o := range ints {
+		if o.plan.IsNull() || o.plan.IsUnknown() || (!o.prior.IsNull() && o.prior.ValueInt64() == o.plan.ValueInt64()) {
+			continue
+		}
+		opts = append(opts, fmt.Sprintf("%s = %d", o.option, o.plan.ValueInt64()))
+	}
+	for _, s := range consumerGroupRAMLimits {
+		v := *s.value(plan)
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if prior != nil && sameRAMLimit(s.value(prior).ValueString(), v.ValueString()) {
+			continue
+		}
+		limit := strings.ToUpper(strings.TrimSpace(v.ValueString()))
+		opts = append(opts, fmt.Sprintf("%s = '%s'", s.option, escapeStringLiteral(limit)))
+	}
+	return opts
+}
+
+// ramLimitPattern matches the forms Exasol accepts for the *_TEMP_DB_RAM_LIMIT options:
+// an absolute size with an optional unit, a percentage, or OFF.
+var ramLimitPattern = regexp.MustCompile(`^(?:(\d+)\s*([KMGT]?)|(\d+(?:\.\d+)?)\s*%|OFF)$`)
+
+var ramLimitUnits = []struct {
+	suffix string
+	bytes  int64
+}{{"T", 1 << 40}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10}}
+
+// normalizeRAMLimit returns the canonical form of a RAM limit: the byte count for absolute
+// sizes, "<n>%" for percentages and "OFF" for no limit. ok is false for anything else.
+func normalizeRAMLimit(s string) (string, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	m := ramLimitPattern.FindStringSubmatch(s)
+	switch {
+	case m == nil:
+		return "", false
+	case m[1] != "":
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return "", false
+		}
+		for _, u := range ramLimitUnits {
+			if m[2] == u.suffix {
+				n *= u.bytes
+			}
+		}
+		return strconv.FormatInt(n, 10), true
+	case m[3] != "":
+		p, err := strconv.ParseFloat(m[3], 64)
+		if err != nil || p > 100 {
+			return "", false
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64) + "%", true
+	default:
+		return "OFF", true
+	}
+}
+
+// sameRAMLimit reports whether two RAM limits are equivalent, e.g. '1G' and '1024M'.
+func sameRAMLimit(a, b string) bool {
+	na, okA := normalizeRAMLimit(a)
+	nb, okB := normalizeRAMLimit(b)
+	return okA && okB && na == nb
+}
+
+// reconcileRAMLimit keeps the configured spelling while EXA_CONSUMER_GROUPS reports an
+// equivalent value and otherwise surfaces the stored one. Unmanaged (null) limits stay null.
+func reconcileRAMLimit(configured types.String, actual any) types.String {
+	if configured.IsNull() {
+		return types.StringNull()
+	}
+	stored := "OFF"
+	switch v := actual.(type) {
+	case nil:
+	case float64:
+		stored = strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		stored = strconv.FormatInt(v, 10)
+	case []byte:
+		stored = string(v)
+	default:
+		stored = fmt.Sprint(v)
+	}
+	if sameRAMLimit(configured.ValueString(), stored) {
+		return configured
+	}
+	return types.StringValue(displayRAMLimit(stored))
+}
+
+// displayRAMLimit renders a byte count in the largest unit that divides it exactly.
+func displayRAMLimit(stored string) string {
+	n, err := strconv.ParseInt(stored, 10, 64)
+	if err != nil || n == 0 {
+		return stored
+	}
+	for _, u := range ramLimitUnits {
+		if n%u.bytes == 0 {
+			return fmt.Sprintf("%d%s", n/u.bytes, u.suffix)
+		}
+	}
+	return stored
+}
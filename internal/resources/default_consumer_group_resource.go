@@ -0,0 +1,280 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DefaultConsumerGroupResource{}
+var _ resource.ResourceWithImportState = &DefaultConsumerGroupResource{}
+
+const (
+	defaultConsumerGroupID = "default_consumer_group"
+	// builtinDefaultConsumerGroup is Exasol's default when nothing else was captured.
+	builtinDefaultConsumerGroup = "MEDIUM"
+)
+
+// DefaultConsumerGroupResource manages the database-wide DEFAULT_CONSUMER_GROUP parameter,
+// used for sessions whose user or role has no consumer group of its own.
+// It is a singleton: declare it at most once per database.
+type DefaultConsumerGroupResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+func NewDefaultConsumerGroupResource() resource.Resource {
+	return &DefaultConsumerGroupResource{}
+}
+
+func (r *DefaultConsumerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_consumer_group"
+}
+
+func (r *DefaultConsumerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the database-wide default consumer group (ALTER SYSTEM SET DEFAULT_CONSUMER_GROUP). " +
+			"Singleton: declare it at most once. Destroying it restores the value found at creation.",
+		Attributes: map[string]schema.Attribute{
+			"consumer_group": schema.StringAttribute{
+				Required:    true,
+				Description: "Consumer group to use by default. Must exist in EXA_CONSUMER_GROUPS.",
+			},
+			"previous_consumer_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "Default consumer group found at creation; restored on destroy (MEDIUM after an import).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: `Always "default_consumer_group".`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DefaultConsumerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type defaultConsumerGroupModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ConsumerGroup         types.String `tfsdk:"consumer_group"`
+	PreviousConsumerGroup types.String `tfsdk:"previous_consumer_group"`
+	LastAppliedSQL        types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *DefaultConsumerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_default_consumer_group")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan defaultConsumerGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	previous, err := readDefaultConsumerGroup(ctx, r.db)
+	if err != nil {
+		resp.Diagnostics.AddError("Read default consumer group failed", err.Error())
+		return
+	}
+	if !r.setDefaultConsumerGroup(ctx, plan.ConsumerGroup.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = types.StringValue(defaultConsumerGroupID)
+	plan.PreviousConsumerGroup = types.StringValue(previous)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DefaultConsumerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var state defaultConsumerGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current string
+	err := retryRead(ctx, func() error {
+		var err error
+		current, err = readDefaultConsumerGroup(ctx, r.db)
+		return err
+	})
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read default consumer group failed", err)
+		return
+	}
+
+	// Keep the configured spelling unless the value really changed
+	if !strings.EqualFold(state.ConsumerGroup.ValueString(), current) {
+		state.ConsumerGroup = types.StringValue(current)
+	}
+	state.ID = types.StringValue(defaultConsumerGroupID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DefaultConsumerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_default_consumer_group")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, state defaultConsumerGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	if !r.setDefaultConsumerGroup(ctx, plan.ConsumerGroup.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = types.StringValue(defaultConsumerGroupID)
+	plan.PreviousConsumerGroup = state.PreviousConsumerGroup
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DefaultConsumerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_default_consumer_group")
+
+	var state defaultConsumerGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	restore := builtinDefaultConsumerGroup
+	if !state.PreviousConsumerGroup.IsNull() && state.PreviousConsumerGroup.ValueString() != "" {
+		restore = state.PreviousConsumerGroup.ValueString()
+	}
+	r.setDefaultConsumerGroup(ctx, restore, &resp.Diagnostics)
+}
+
+func (r *DefaultConsumerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != defaultConsumerGroupID {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected %q", defaultConsumerGroupID))
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("id"), defaultConsumerGroupID)
+	resp.State.SetAttribute(ctx, path.Root("previous_consumer_group"), types.StringNull())
+}
+
+// setDefaultConsumerGroup runs ALTER SYSTEM SET DEFAULT_CONSUMER_GROUP after checking that the
+// group exists, so a typo (or a since-dropped previous group) fails with a clear message.
+func (r *DefaultConsumerGroupResource) setDefaultConsumerGroup(ctx context.Context, group string, diags *diag.Diagnostics) bool {
+	// exasol_consumer_group always stores the name uppercased
+	name := strings.ToUpper(group)
+	var found string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT CONSUMER_GROUP_NAME FROM EXA_CONSUMER_GROUPS WHERE CONSUMER_GROUP_NAME = ?`, name).Scan(&found)
+	if err == sql.ErrNoRows {
+		diags.AddAttributeError(path.Root("consumer_group"), "Unknown consumer group",
+			fmt.Sprintf("Consumer group %q does not exist in EXA_CONSUMER_GROUPS.", name))
+		return false
+	}
+	if err != nil {
+		diags.AddError("Read consumer groups failed", err.Error())
+		return false
+	}
+
+	stmt := fmt.Sprintf(`ALTER SYSTEM SET DEFAULT_CONSUMER_GROUP = '%s'`, escapeStringLiteral(name))
+	tflog.Info(ctx, "Setting default consumer group", map[string]any{"sql": stmt})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		diags.AddError("ALTER SYSTEM SET DEFAULT_CONSUMER_GROUP failed", err.Error())
+		return false
+	}
+	return true
+}
+
+// readDefaultConsumerGroup returns the current system value of DEFAULT_CONSUMER_GROUP.
+func readDefaultConsumerGroup(ctx context.Context, db *sql.DB) (string, error) {
+	var value sql.NullString
+	err := db.QueryRowContext(ctx,
+		`SELECT SYSTEM_VALUE FROM EXA_PARAMETERS WHERE PARAMETER_NAME = 'DEFAULT_CONSUMER_GROUP'`).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("DEFAULT_CONSUMER_GROUP is not listed in EXA_PARAMETERS; consumer groups require Exasol 7.0 or later")
+	}
+	if err != nil {
+		return "", err
+	}
+	return value.String, nil
+}
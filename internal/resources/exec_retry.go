@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultCollisionRetries is how often a DDL/DCL statement is repeated after a transaction
+// collision unless the provider's collision_retries says otherwise.
+const DefaultCollisionRetries = 5
+
+// execRetryDelay is the wait before the first retry; it doubles after each attempt.
+const execRetryDelay = 100 * time.Millisecond
+
+// isTransactionCollision reports whether err is Exasol's transaction collision (SQL error 40001).
+// The colliding statement was rolled back, so it is safe to run it again.
+func isTransactionCollision(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "40001")
+}
+
+// execWithCollisionRetry runs a mutating statement and repeats it with exponential backoff and
+// jitter when it loses a transaction collision, at most retries times (the provider's
+// collision_retries, which resources keep from Configure). Other errors, including connection failures where
+// the statement may already have been applied, are returned immediately.
+//
+// Wrapping each statement in its own BeginTx/Commit does not help here: the driver runs with
+// autocommit, so every statement already commits on its own, and BeginTx is rejected while
+// autocommit is enabled. Collisions come from concurrent statements on the same system tables;
+// the jitter keeps parallel deletes that collided once from colliding again on the next attempt.
+func execWithCollisionRetry(ctx context.Context, db *sql.DB, retries int, stmt string) error {
+	delay := execRetryDelay
+	for attempt := 0; ; attempt++ {
+		_, err := db.ExecContext(ctx, stmt)
+		if !isTransactionCollision(err) || attempt >= retries {
+			return err
+		}
+		wait := delay + rand.N(delay/2)
+		tflog.Warn(ctx, "Transaction collision detected, retrying", map[string]any{
+			"attempt":    attempt + 1,
+			"maxRetries": retries,
+			"waitMs":     wait.Milliseconds(),
+		})
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+// isMissingGrantError reports whether a REVOKE failed because there is nothing left to revoke:
+// the privilege or role was not granted, or the grantee or object no longer exists.
+func isMissingGrantError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "not granted") ||
+		strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "does not exist")
+}
+
+// execRevoke runs a REVOKE with execWithCollisionRetry. With ignoreMissing (the provider's
+// ignore_missing_on_revoke) a REVOKE of a grant that is already gone is logged and succeeds.
+func execRevoke(ctx context.Context, db *sql.DB, retries int, stmt string, ignoreMissing bool) error {
+	err := execWithCollisionRetry(ctx, db, retries, stmt)
+	if ignoreMissing && isMissingGrantError(err) {
+		tflog.Warn(ctx, "Grant no longer exists, treating REVOKE as done", map[string]any{"sql": stmt, "error": err.Error()})
+		return nil
+	}
+	return err
+}
@@ -4,23 +4,36 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &GrantResource{}
 var _ resource.ResourceWithImportState = &GrantResource{}
+var _ resource.ResourceWithValidateConfig = &GrantResource{}
 
 // GrantResource implements a generic Exasol GRANT/REVOKE resource.
 type GrantResource struct {
-	db *sql.DB
+	db                    *sql.DB
+	quoteIdentifiers      bool
+	viewScope             string
+	queryTimeout          time.Duration
+	readTimeout           time.Duration
+	deleteTimeout         time.Duration
+	collisionRetries      int
+	ignoreMissingOnRevoke bool
+	readPermissionPolicy  string
+	operations            *exasolclient.OperationLimiter
 }
 
 func NewGrantResource() resource.Resource { return &GrantResource{} }
@@ -51,15 +64,32 @@ func (r *GrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"object_type": schema.StringAttribute{
 				Optional:    true,
 				Description: `Object type for OBJECT privileges (e.g. SCHEMA, TABLE, VIEW). Use "ROLE" for role grants.`,
+				Validators: []validator.String{
+					objectTypeValidator{allowed: append(slices.Clone(supportedObjectTypes), "ROLE")},
+				},
 			},
 			"object_name": schema.StringAttribute{
 				Optional:    true,
 				Description: "Qualified object name for OBJECT privileges (e.g. MYSCHEMA.MYTABLE or MYSCHEMA). For role grants with OBJECT privilege_type, this should contain the role name.",
 			},
+			"acknowledge_broad_privilege": acknowledgeBroadPrivilegeAttribute(),
 			"with_admin_option": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Grants the privilege/role with ADMIN OPTION. Applies to SYSTEM privileges and role grants.",
 			},
+			"grantor": schema.StringAttribute{
+				Computed: true,
+				Description: "User who granted the OBJECT privilege, from the GRANTOR column of the object privilege view (comma-separated if several " +
+					"did). Null for SYSTEM privileges and role grants, whose system views do not record a grantor.",
+			},
+			"verify_after_create": schema.BoolAttribute{
+				Optional: true,
+				Description: "After the GRANT, poll the system views until the grant is visible before finishing the apply. " +
+					"Guards against clusters where a new grant is not yet visible to the next Read, which would otherwise " +
+					"remove it from state. Default false.",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Synthetic ID representing the granted privilege or role.",
@@ -74,6 +104,15 @@ func (r *GrantResource) Configure(_ context.Context, req resource.ConfigureReque
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.ignoreMissingOnRevoke = c.IgnoreMissingOnRevoke
+		r.operations = c.Operations
 	}
 }
 
@@ -85,9 +124,37 @@ type grantModel struct {
 	ObjectType      types.String `tfsdk:"object_type"`
 	ObjectName      types.String `tfsdk:"object_name"`
 	WithAdminOption types.Bool   `tfsdk:"with_admin_option"`
+	AckBroad        types.Bool   `tfsdk:"acknowledge_broad_privilege"`
+	Grantor         types.String `tfsdk:"grantor"`
+	VerifyAfter     types.Bool   `tfsdk:"verify_after_create"`
+	Protected       types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL  types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *GrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg grantModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rejectPublicAdminOption(&resp.Diagnostics, path.Root("grantee_name"), cfg.GranteeName, cfg.WithAdminOption)
+	// For object_type ROLE the privilege names a role, not a privilege
+	if !strings.EqualFold(cfg.ObjectType.ValueString(), "ROLE") {
+		warnBroadPrivilege(&resp.Diagnostics, path.Root("privilege"), cfg.Privilege, cfg.AckBroad)
+	}
 }
 
 func (r *GrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -98,22 +165,34 @@ func (r *GrantResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	sqlGrant, err := buildGrantSQL(plan)
+	sqlGrant, err := buildGrantSQL(plan, r.quoteIdentifiers)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid grant", err.Error())
 		return
 	}
 	tflog.Info(ctx, "Executing GRANT", map[string]any{"sql": sqlGrant})
-	if _, err := r.db.ExecContext(ctx, sqlGrant); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, sqlGrant); err != nil {
 		resp.Diagnostics.AddError("GRANT failed", err.Error())
 		return
 	}
 
-	plan.ID = types.StringValue(idForGrant(plan))
+	// The grant is committed, so it goes into state before the check: a grant that does not show
+	// up fails the apply but stays tracked and is revoked when the resource is replaced
+	plan.ID = types.StringValue(idForGrant(plan, r.quoteIdentifiers))
+	plan.Grantor = readGrantGrantor(ctx, r.db, r.viewScope, r.quoteIdentifiers, plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if plan.VerifyAfter.ValueBool() && !resp.Diagnostics.HasError() {
+		if err := waitForGrant(ctx, r.db, r.viewScope, r.quoteIdentifiers, plan); err != nil {
+			resp.Diagnostics.AddError("Grant not visible after GRANT", err.Error())
+		}
+	}
 }
 
 func (r *GrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -125,9 +204,14 @@ func (r *GrantResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	exists, err := checkGrantExists(ctx, r.db, state)
+	var exists bool
+	err := retryRead(ctx, func() error {
+		var err error
+		exists, err = checkGrantExists(ctx, r.db, r.viewScope, r.quoteIdentifiers, state)
+		return err
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read grant", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Failed to read grant", err)
 		return
 	}
 	if !exists {
@@ -136,11 +220,22 @@ func (r *GrantResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Re-assert ID to ensure Terraform never sees it as unknown
-	state.ID = types.StringValue(idForGrant(state))
+	state.ID = types.StringValue(idForGrant(state, r.quoteIdentifiers))
+	state.Grantor = readGrantGrantor(ctx, r.db, r.viewScope, r.quoteIdentifiers, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *GrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -162,44 +257,92 @@ func (r *GrantResource) Update(ctx context.Context, req resource.UpdateRequest,
 			})
 
 		// Update only the Terraform state
-		plan.ID = types.StringValue(idForGrant(plan))
+		plan.ID = types.StringValue(idForGrant(plan, r.quoteIdentifiers))
+		plan.Grantor = readGrantGrantor(ctx, r.db, r.viewScope, r.quoteIdentifiers, plan)
+		plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
 
-	oldID := idForGrant(state)
-	newID := idForGrant(plan)
+	oldID := idForGrant(state, r.quoteIdentifiers)
+	newID := idForGrant(plan, r.quoteIdentifiers)
 
 	if oldID != newID {
 		// First revoke the old grant
-		sqlRevoke, err := buildRevokeSQL(state)
+		sqlRevoke, err := buildRevokeSQL(state, r.quoteIdentifiers)
 		if err != nil {
 			resp.Diagnostics.AddError("Invalid revoke statement", err.Error())
 			return
 		}
 
 		tflog.Info(ctx, "Revoking old grant", map[string]any{"sql": sqlRevoke})
-		if _, err := r.db.ExecContext(ctx, sqlRevoke); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, sqlRevoke); err != nil {
 			resp.Diagnostics.AddError("REVOKE failed", err.Error())
 			return
 		}
 
 		// Then create the new grant
-		sqlGrant, err := buildGrantSQL(plan)
+		sqlGrant, err := buildGrantSQL(plan, r.quoteIdentifiers)
 		if err != nil {
 			resp.Diagnostics.AddError("Invalid grant statement", err.Error())
 			return
 		}
 
 		tflog.Info(ctx, "Creating new grant", map[string]any{"sql": sqlGrant})
-		if _, err := r.db.ExecContext(ctx, sqlGrant); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, sqlGrant); err != nil {
 			resp.Diagnostics.AddError("GRANT failed", err.Error())
 			return
 		}
 	}
 
 	plan.ID = types.StringValue(newID)
+	plan.Grantor = readGrantGrantor(ctx, r.db, r.viewScope, r.quoteIdentifiers, plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if oldID != newID && plan.VerifyAfter.ValueBool() && !resp.Diagnostics.HasError() {
+		if err := waitForGrant(ctx, r.db, r.viewScope, r.quoteIdentifiers, plan); err != nil {
+			resp.Diagnostics.AddError("Grant not visible after GRANT", err.Error())
+		}
+	}
+}
+
+// grantVerifyAttempts is how often verify_after_create looks for a new grant.
+const grantVerifyAttempts = 6
+
+// grantVerifyDelay is the wait before the second look; it doubles after each attempt, so all
+// attempts together wait about 8 seconds.
+const grantVerifyDelay = 250 * time.Millisecond
+
+// waitForGrant polls checkGrantExists until the grant of m is visible in the system views. It
+// fails when the grant is still missing after grantVerifyAttempts, or with the last query error.
+func waitForGrant(ctx context.Context, db *sql.DB, scope string, quoted bool, m grantModel) error {
+	delay := grantVerifyDelay
+	for attempt := 1; ; attempt++ {
+		exists, err := checkGrantExists(ctx, db, scope, quoted, m)
+		if err != nil && !isTransientError(err) {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		if attempt == grantVerifyAttempts {
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("the GRANT succeeded but the grant was still not visible after %d checks; "+
+				"the next refresh may not find it and remove it from state", grantVerifyAttempts)
+		}
+		tflog.Debug(ctx, "Grant not visible yet, polling", map[string]any{
+			"attempt": attempt,
+			"waitMs":  delay.Milliseconds(),
+		})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for the grant to become visible: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
 }
 
 // isSchemaObjectRename checks if this update is just a schema rename where
@@ -214,16 +357,22 @@ func isSchemaObjectRename(plan, state grantModel) bool {
 	}
 
 	// Only object_name should have changed
-	return plan.GranteeName.ValueString() == state.GranteeName.ValueString() &&
-		plan.Privilege.ValueString() == state.Privilege.ValueString() &&
+	return strings.EqualFold(plan.GranteeName.ValueString(), state.GranteeName.ValueString()) &&
+		normalizePrivilege(plan.Privilege.ValueString()) == normalizePrivilege(state.Privilege.ValueString()) &&
 		plan.WithAdminOption.ValueBool() == state.WithAdminOption.ValueBool() &&
 		plan.ObjectName.ValueString() != state.ObjectName.ValueString()
 }
 
 func (r *GrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_grant")
 
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
@@ -235,12 +384,20 @@ func (r *GrantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	sqlRevoke, err := buildRevokeSQL(state)
+	if refuseProtectedDelete(state.Protected, "exasol_grant "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	sqlRevoke, err := buildRevokeSQL(state, r.quoteIdentifiers)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid revoke", err.Error())
 		return
 	}
-	if _, err := r.db.ExecContext(ctx, sqlRevoke); err != nil {
+	if !state.Grantor.IsNull() && !state.Grantor.IsUnknown() {
+		warnForeignGrantors(ctx, r.db, &resp.Diagnostics,
+			fmt.Sprintf("%s on %s", normalizePrivilege(state.Privilege.ValueString()), state.ObjectName.ValueString()),
+			strings.Split(state.Grantor.ValueString(), ", "))
+	}
+	if err := execRevoke(ctx, r.db, r.collisionRetries, sqlRevoke, r.ignoreMissingOnRevoke); err != nil {
 		resp.Diagnostics.AddError("REVOKE failed", err.Error())
 	}
 }
@@ -266,12 +423,17 @@ func (r *GrantResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
 }
 
-func idForGrant(m grantModel) string {
+// idForGrant builds the synthetic ID. Every part is normalized the way the grant statement
+// treats it, so spellings that grant the same thing yield the same ID.
+func idForGrant(m grantModel, quoted bool) string {
 	grantee := strings.ToUpper(m.GranteeName.ValueString())
 	pt := strings.ToUpper(m.PrivilegeType.ValueString())
-	priv := strings.ToUpper(m.Privilege.ValueString())
+	priv := normalizePrivilege(m.Privilege.ValueString())
 	objType := strings.ToUpper(m.ObjectType.ValueString())
-	objName := m.ObjectName.ValueString()
+	objName := canonicalQualifiedIdent(m.ObjectName.ValueString(), quoted)
+	if objType == "ROLE" {
+		objName = strings.ToUpper(m.ObjectName.ValueString()) // roles are always granted uppercased
+	}
 	withAdmin := fmt.Sprintf("%t", m.WithAdminOption.ValueBool())
 
 	return strings.Join([]string{
@@ -279,7 +441,7 @@ func idForGrant(m grantModel) string {
 	}, "|")
 }
 
-func buildGrantSQL(m grantModel) (string, error) {
+func buildGrantSQL(m grantModel, quoted bool) (string, error) {
 	granteeName := strings.ToUpper(m.GranteeName.ValueString())
 
 	// Validate grantee name
@@ -287,8 +449,22 @@ func buildGrantSQL(m grantModel) (string, error) {
 		return "", fmt.Errorf("invalid grantee name %q: must start with a letter and contain only letters, digits, and underscores", m.GranteeName.ValueString())
 	}
 
-	grantee := fmt.Sprintf(`"%s"`, granteeName)
-	priv := strings.ToUpper(m.Privilege.ValueString())
+	if role, ok := grantedRole(m); ok {
+		sql, err := buildRoleGrantSQL(role, granteeName, quoted)
+		if err != nil {
+			return "", err
+		}
+		if m.WithAdminOption.ValueBool() {
+			sql += " WITH ADMIN OPTION"
+		}
+		return sql, nil
+	}
+
+	grantee, err := quoteIdent(granteeName, quoted)
+	if err != nil {
+		return "", err
+	}
+	priv := normalizePrivilege(m.Privilege.ValueString())
 
 	switch strings.ToUpper(m.PrivilegeType.ValueString()) {
 	case "SYSTEM":
@@ -302,14 +478,17 @@ func buildGrantSQL(m grantModel) (string, error) {
 			return "", fmt.Errorf("object_type and object_name are required for OBJECT privileges")
 		}
 		objType := strings.ToUpper(m.ObjectType.ValueString())
-		objName := qualify(m.ObjectName.ValueString())
+		objName, err := qualifyIdent(m.ObjectName.ValueString(), quoted)
+		if err != nil {
+			return "", err
+		}
 		return fmt.Sprintf(`GRANT %s ON %s %s TO %s`, priv, objType, objName, grantee), nil
 	default:
 		return "", fmt.Errorf("privilege_type must be SYSTEM or OBJECT")
 	}
 }
 
-func buildRevokeSQL(m grantModel) (string, error) {
+func buildRevokeSQL(m grantModel, quoted bool) (string, error) {
 	granteeName := strings.ToUpper(m.GranteeName.ValueString())
 
 	// Validate grantee name
@@ -317,8 +496,16 @@ func buildRevokeSQL(m grantModel) (string, error) {
 		return "", fmt.Errorf("invalid grantee name %q: must start with a letter and contain only letters, digits, and underscores", m.GranteeName.ValueString())
 	}
 
-	grantee := fmt.Sprintf(`"%s"`, granteeName)
-	priv := strings.ToUpper(m.Privilege.ValueString())
+	// REVOKE removes the role together with any ADMIN OPTION, so with_admin_option is ignored here
+	if role, ok := grantedRole(m); ok {
+		return buildRoleRevokeSQL(role, granteeName, quoted)
+	}
+
+	grantee, err := quoteIdent(granteeName, quoted)
+	if err != nil {
+		return "", err
+	}
+	priv := normalizePrivilege(m.Privilege.ValueString())
 
 	switch strings.ToUpper(m.PrivilegeType.ValueString()) {
 	case "SYSTEM":
@@ -328,24 +515,41 @@ func buildRevokeSQL(m grantModel) (string, error) {
 			return "", fmt.Errorf("object_type and object_name are required for OBJECT privileges")
 		}
 		objType := strings.ToUpper(m.ObjectType.ValueString())
-		objName := qualify(m.ObjectName.ValueString())
+		objName, err := qualifyIdent(m.ObjectName.ValueString(), quoted)
+		if err != nil {
+			return "", err
+		}
 		return fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, priv, objType, objName, grantee), nil
 	default:
 		return "", fmt.Errorf("privilege_type must be SYSTEM or OBJECT")
 	}
 }
 
-func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, error) {
+// grantedRole returns the role name when the grant is a role grant (object_type = "ROLE").
+// With privilege_type OBJECT the role is taken from object_name, with SYSTEM from privilege.
+// Role grants are emitted as plain GRANT <role> TO <grantee>; "GRANT ... ON ROLE ..." is not valid SQL.
+func grantedRole(m grantModel) (string, bool) {
+	if m.ObjectType.IsNull() || !strings.EqualFold(m.ObjectType.ValueString(), "ROLE") {
+		return "", false
+	}
+	if strings.EqualFold(m.PrivilegeType.ValueString(), "OBJECT") && !m.ObjectName.IsNull() && m.ObjectName.ValueString() != "" {
+		return strings.ToUpper(m.ObjectName.ValueString()), true
+	}
+	return strings.ToUpper(m.Privilege.ValueString()), true
+}
+
+// checkGrantExists looks m up in the system views of scope, resolving object names with the
+// quote_identifiers setting the grant was issued with.
+func checkGrantExists(ctx context.Context, db *sql.DB, scope string, quoted bool, m grantModel) (bool, error) {
 	granteeName := strings.ToUpper(m.GranteeName.ValueString())
-	privilege := strings.ToUpper(m.Privilege.ValueString())
+	privilege := normalizePrivilege(m.Privilege.ValueString())
 
 	switch strings.ToUpper(m.PrivilegeType.ValueString()) {
 	case "SYSTEM":
 		// Check if this is actually a ROLE grant (when object_type = "ROLE")
 		if !m.ObjectType.IsNull() && strings.EqualFold(m.ObjectType.ValueString(), "ROLE") {
 			// This is a role grant, not a system privilege
-			// Query EXA_DBA_ROLE_PRIVS for role assignments
-			query := `SELECT 1 FROM EXA_DBA_ROLE_PRIVS WHERE GRANTEE = ? AND GRANTED_ROLE = ?`
+			query := `SELECT 1 FROM ` + rolePrivsView(scope) + ` WHERE GRANTEE = ? AND GRANTED_ROLE = ?`
 			var dummy int
 			err := db.QueryRowContext(ctx, query, granteeName, privilege).Scan(&dummy)
 			if err == sql.ErrNoRows {
@@ -357,8 +561,7 @@ func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, erro
 			return true, nil
 		}
 
-		// Query EXA_DBA_SYS_PRIVS for system privileges
-		query := `SELECT 1 FROM EXA_DBA_SYS_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = ?`
+		query := `SELECT 1 FROM ` + sysPrivsView(scope) + ` WHERE GRANTEE = ? AND PRIVILEGE = ?`
 		var dummy int
 		err := db.QueryRowContext(ctx, query, granteeName, privilege).Scan(&dummy)
 		if err == sql.ErrNoRows {
@@ -375,15 +578,14 @@ func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, erro
 		}
 
 		objType := strings.ToUpper(m.ObjectType.ValueString())
-		objName := strings.ToUpper(m.ObjectName.ValueString())
+		objName := m.ObjectName.ValueString()
 
 		// Special handling for ROLE type - this is actually a role grant
 		if strings.EqualFold(objType, "ROLE") {
-			// Query EXA_DBA_ROLE_PRIVS for role assignments
 			// In this case, object_name contains the role name
-			query := `SELECT 1 FROM EXA_DBA_ROLE_PRIVS WHERE GRANTEE = ? AND GRANTED_ROLE = ?`
+			query := `SELECT 1 FROM ` + rolePrivsView(scope) + ` WHERE GRANTEE = ? AND GRANTED_ROLE = ?`
 			var dummy int
-			err := db.QueryRowContext(ctx, query, granteeName, objName).Scan(&dummy)
+			err := db.QueryRowContext(ctx, query, granteeName, strings.ToUpper(objName)).Scan(&dummy)
 			if err == sql.ErrNoRows {
 				return false, nil
 			}
@@ -393,9 +595,10 @@ func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, erro
 			return true, nil
 		}
 
-		// For object privileges, query EXA_DBA_OBJ_PRIVS
-		// The object name might be schema-qualified (e.g., "SCHEMA.TABLE")
-		// We need to handle both single identifiers and qualified names
+		// The object name might be schema-qualified (e.g., "SCHEMA.TABLE");
+		// objectPrivilegeMatch splits it into OBJECT_SCHEMA and OBJECT_NAME
+		view := objPrivsView(scope)
+		match, matchArgs := objectPrivilegeMatch(objType, "", canonicalQualifiedIdent(objName, quoted))
 
 		tflog.Debug(ctx, "Checking object privilege existence", map[string]any{
 			"grantee":     granteeName,
@@ -409,25 +612,25 @@ func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, erro
 		// We need to check both possibilities
 		if privilege == "ALL" {
 			// First, try to find "ALL" privilege directly
-			query := `SELECT 1 FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = 'ALL' AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+			query := `SELECT 1 FROM ` + view + ` WHERE GRANTEE = ? AND PRIVILEGE = 'ALL' AND ` + match
 			var dummy int
-			err := db.QueryRowContext(ctx, query, granteeName, objType, objName).Scan(&dummy)
+			err := db.QueryRowContext(ctx, query, append([]any{granteeName}, matchArgs...)...).Scan(&dummy)
 			if err == nil {
-				tflog.Debug(ctx, "Object privilege 'ALL' found in EXA_DBA_OBJ_PRIVS")
+				tflog.Debug(ctx, "Object privilege 'ALL' found", map[string]any{"view": view})
 				return true, nil
 			}
 			if err != sql.ErrNoRows {
-				tflog.Error(ctx, "Error querying EXA_DBA_OBJ_PRIVS for ALL", map[string]any{"error": err.Error()})
+				tflog.Error(ctx, "Error querying object privileges for ALL", map[string]any{"view": view, "error": err.Error()})
 				return false, err
 			}
 
 			// If "ALL" is not found directly, check if any individual privileges exist
 			// This covers the case where "ALL" was expanded into individual privileges
-			countQuery := `SELECT COUNT(*) FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+			countQuery := `SELECT COUNT(*) FROM ` + view + ` WHERE GRANTEE = ? AND ` + match
 			var count int
-			err = db.QueryRowContext(ctx, countQuery, granteeName, objType, objName).Scan(&count)
+			err = db.QueryRowContext(ctx, countQuery, append([]any{granteeName}, matchArgs...)...).Scan(&count)
 			if err != nil {
-				tflog.Error(ctx, "Error counting privileges in EXA_DBA_OBJ_PRIVS", map[string]any{"error": err.Error()})
+				tflog.Error(ctx, "Error counting object privileges", map[string]any{"view": view, "error": err.Error()})
 				return false, err
 			}
 			if count > 0 {
@@ -439,21 +642,43 @@ func checkGrantExists(ctx context.Context, db *sql.DB, m grantModel) (bool, erro
 		}
 
 		// For non-ALL privileges, query directly
-		query := `SELECT 1 FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = ? AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+		query := `SELECT 1 FROM ` + view + ` WHERE GRANTEE = ? AND PRIVILEGE = ? AND ` + match
 		var dummy int
-		err := db.QueryRowContext(ctx, query, granteeName, privilege, objType, objName).Scan(&dummy)
+		err := db.QueryRowContext(ctx, query, append([]any{granteeName, privilege}, matchArgs...)...).Scan(&dummy)
 		if err == sql.ErrNoRows {
-			tflog.Debug(ctx, "Object privilege not found in EXA_DBA_OBJ_PRIVS")
+			tflog.Debug(ctx, "Object privilege not found", map[string]any{"view": view})
 			return false, nil
 		}
 		if err != nil {
-			tflog.Error(ctx, "Error querying EXA_DBA_OBJ_PRIVS", map[string]any{"error": err.Error()})
+			tflog.Error(ctx, "Error querying object privileges", map[string]any{"view": view, "error": err.Error()})
 			return false, err
 		}
-		tflog.Debug(ctx, "Object privilege found in EXA_DBA_OBJ_PRIVS")
+		tflog.Debug(ctx, "Object privilege found", map[string]any{"view": view})
 		return true, nil
 
 	default:
 		return false, fmt.Errorf("privilege_type must be SYSTEM or OBJECT")
 	}
 }
+
+// readGrantGrantor returns the grantor(s) of an OBJECT privilege grant. The other grant kinds
+// have no grantor column, and the attribute is informational, so errors yield null.
+func readGrantGrantor(ctx context.Context, db *sql.DB, scope string, quoted bool, m grantModel) types.String {
+	if _, isRole := grantedRole(m); isRole || !strings.EqualFold(m.PrivilegeType.ValueString(), "OBJECT") {
+		return types.StringNull()
+	}
+	privilege := normalizePrivilege(m.Privilege.ValueString())
+	if privilege == "ALL" {
+		privilege = "" // Exasol may store ALL expanded into the individual privileges
+	}
+	grantors, err := readObjectPrivilegeGrantors(ctx, db, scope,
+		strings.ToUpper(m.GranteeName.ValueString()), strings.ToUpper(m.ObjectType.ValueString()), "",
+		canonicalQualifiedIdent(m.ObjectName.ValueString(), quoted), privilege)
+	if err != nil || len(grantors) == 0 {
+		if err != nil {
+			tflog.Debug(ctx, "Could not read grant grantor", map[string]any{"error": err.Error()})
+		}
+		return types.StringNull()
+	}
+	return types.StringValue(strings.Join(grantors, ", "))
+}
@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The functions below expose the grant statement builders and existence checks of the grant
+// resources to tools that work outside Terraform, such as cmd/replicate-grants, so that they
+// render exactly the SQL the resources would.
+
+// GrantSQL renders the GRANT statement of an exasol_grant with the given attributes. A role grant
+// is privilegeType SYSTEM with objectType ROLE and the role as privilege.
+func GrantSQL(grantee, privilegeType, privilege, objectType, objectName string, withAdminOption, quoted bool) (string, error) {
+	m := grantModel{
+		GranteeName:     types.StringValue(grantee),
+		PrivilegeType:   types.StringValue(privilegeType),
+		Privilege:       types.StringValue(privilege),
+		ObjectType:      types.StringNull(),
+		ObjectName:      types.StringNull(),
+		WithAdminOption: types.BoolValue(withAdminOption),
+	}
+	if objectType != "" {
+		m.ObjectType = types.StringValue(objectType)
+	}
+	if objectName != "" {
+		m.ObjectName = types.StringValue(objectName)
+	}
+	return buildGrantSQL(m, quoted)
+}
+
+// ConnectionGrantSQL renders the GRANT CONNECTION statement of an exasol_connection_grant.
+func ConnectionGrantSQL(connection, grantee string, withAdminOption, quoted bool) (string, error) {
+	stmt, err := buildConnectionGrantSQL(connectionPrivilegeConnection, connection, grantee, quoted)
+	if err != nil {
+		return "", err
+	}
+	if withAdminOption {
+		stmt += " WITH ADMIN OPTION"
+	}
+	return stmt, nil
+}
+
+// GranteeExists reports whether grantee exists as a user or role.
+func GranteeExists(ctx context.Context, db *sql.DB, grantee string) (bool, error) {
+	return granteeExists(ctx, db, grantee)
+}
+
+// GrantObjectExists reports whether the object, connection or role (objectType ROLE) a grant
+// refers to exists. See grantObjectExists for the accepted names.
+func GrantObjectExists(ctx context.Context, db *sql.DB, objectType, name string) (bool, error) {
+	return grantObjectExists(ctx, db, objectType, name)
+}
+
+// ParseAdminOption is parseAdminOption for use outside this package.
+func ParseAdminOption(v any) bool {
+	return parseAdminOption(v)
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"slices"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GrantSQLDataSource{}
+var _ datasource.DataSourceWithConfigure = &GrantSQLDataSource{}
+
+// GrantSQLDataSource renders the GRANT and REVOKE statements that exasol_grant would run,
+// without executing them, so generated DCL can be reviewed in a pipeline.
+type GrantSQLDataSource struct {
+	quoteIdentifiers bool
+}
+
+func NewGrantSQLDataSource() datasource.DataSource {
+	return &GrantSQLDataSource{quoteIdentifiers: true}
+}
+
+func (d *GrantSQLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant_sql"
+}
+
+func (d *GrantSQLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the GRANT and REVOKE statements exasol_grant would execute for the given inputs. " +
+			"Nothing is executed against the database.",
+		Attributes: map[string]schema.Attribute{
+			"grantee_name": schema.StringAttribute{
+				Required:    true,
+				Description: "User or role name that receives the privilege or role.",
+			},
+			"privilege_type": schema.StringAttribute{
+				Required:    true,
+				Description: `Either "SYSTEM" or "OBJECT".`,
+			},
+			"privilege": schema.StringAttribute{
+				Required:    true,
+				Description: "Privilege name (e.g. USAGE, SELECT, CREATE ANY TABLE...) or role name for role grants.",
+			},
+			"object_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object type for OBJECT privileges (e.g. SCHEMA, TABLE, VIEW).",
+				Validators: []validator.String{
+					objectTypeValidator{allowed: append(slices.Clone(supportedObjectTypes), "ROLE")},
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Qualified object name for OBJECT privileges (e.g. MYSCHEMA.MYTABLE or MYSCHEMA).",
+			},
+			"with_admin_option": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Render the grant WITH ADMIN OPTION. Applies to SYSTEM privileges and role grants.",
+			},
+			"grant_sql": schema.StringAttribute{
+				Computed:    true,
+				Description: "GRANT statement, with secrets redacted.",
+			},
+			"revoke_sql": schema.StringAttribute{
+				Computed:    true,
+				Description: "REVOKE statement, with secrets redacted.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Synthetic ID of the grant, as used by exasol_grant.",
+			},
+		},
+	}
+}
+
+func (d *GrantSQLDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.quoteIdentifiers = c.QuoteIdentifiers
+	}
+}
+
+type grantSQLModel struct {
+	ID              types.String `tfsdk:"id"`
+	GranteeName     types.String `tfsdk:"grantee_name"`
+	PrivilegeType   types.String `tfsdk:"privilege_type"`
+	Privilege       types.String `tfsdk:"privilege"`
+	ObjectType      types.String `tfsdk:"object_type"`
+	ObjectName      types.String `tfsdk:"object_name"`
+	WithAdminOption types.Bool   `tfsdk:"with_admin_option"`
+	GrantSQL        types.String `tfsdk:"grant_sql"`
+	RevokeSQL       types.String `tfsdk:"revoke_sql"`
+}
+
+func (d *GrantSQLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg grantSQLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := grantModel{
+		GranteeName:     cfg.GranteeName,
+		PrivilegeType:   cfg.PrivilegeType,
+		Privilege:       cfg.Privilege,
+		ObjectType:      cfg.ObjectType,
+		ObjectName:      cfg.ObjectName,
+		WithAdminOption: cfg.WithAdminOption,
+	}
+
+	grantSQL, err := buildGrantSQL(m, d.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grant", err.Error())
+		return
+	}
+	revokeSQL, err := buildRevokeSQL(m, d.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grant", err.Error())
+		return
+	}
+
+	cfg.GrantSQL = types.StringValue(sanitizeLogSQL(grantSQL))
+	cfg.RevokeSQL = types.StringValue(sanitizeLogSQL(revokeSQL))
+	cfg.ID = types.StringValue(idForGrant(m, d.quoteIdentifiers))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
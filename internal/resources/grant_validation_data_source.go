@@ -0,0 +1,254 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GrantValidationDataSource{}
+var _ datasource.DataSourceWithConfigure = &GrantValidationDataSource{}
+
+// GrantValidationDataSource checks the prerequisites of a grant without executing it, so modules
+// can pre-flight RBAC changes before apply.
+type GrantValidationDataSource struct {
+	db               *sql.DB
+	quoteIdentifiers bool
+	readTimeout      time.Duration
+	serverVersion    int
+}
+
+func NewGrantValidationDataSource() datasource.DataSource {
+	return &GrantValidationDataSource{}
+}
+
+func (d *GrantValidationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant_validation"
+}
+
+func (d *GrantValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether a grant with the given inputs (the same as exasol_grant) is likely to succeed: " +
+			"the grantee, role or object exists and the privilege fits the object type. Nothing is executed; " +
+			"problems are returned instead of failing the plan.",
+		Attributes: map[string]schema.Attribute{
+			"grantee_name": schema.StringAttribute{
+				Required:    true,
+				Description: "User or role name that would receive the privilege or role.",
+			},
+			"privilege_type": schema.StringAttribute{
+				Required:    true,
+				Description: `Either "SYSTEM" or "OBJECT".`,
+			},
+			"privilege": schema.StringAttribute{
+				Required:    true,
+				Description: "Privilege name, or role name for role grants.",
+			},
+			"object_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object type for OBJECT privileges, or ROLE for role grants.",
+				Validators: []validator.String{
+					objectTypeValidator{allowed: append(slices.Clone(supportedObjectTypes), "ROLE")},
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Qualified object name for OBJECT privileges (e.g. MYSCHEMA.MYTABLE or MYSCHEMA).",
+			},
+			"with_admin_option": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the grant would use WITH ADMIN OPTION.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if no problems were found.",
+			},
+			"problems": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Reasons the grant would fail. Empty when valid.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Synthetic ID of the grant, as used by exasol_grant.",
+			},
+		},
+	}
+}
+
+func (d *GrantValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.quoteIdentifiers = c.QuoteIdentifiers
+		d.readTimeout = c.ReadTimeout
+		d.serverVersion = c.ServerMajorVersion
+	}
+}
+
+type grantValidationModel struct {
+	ID              types.String   `tfsdk:"id"`
+	GranteeName     types.String   `tfsdk:"grantee_name"`
+	PrivilegeType   types.String   `tfsdk:"privilege_type"`
+	Privilege       types.String   `tfsdk:"privilege"`
+	ObjectType      types.String   `tfsdk:"object_type"`
+	ObjectName      types.String   `tfsdk:"object_name"`
+	WithAdminOption types.Bool     `tfsdk:"with_admin_option"`
+	Valid           types.Bool     `tfsdk:"valid"`
+	Problems        []types.String `tfsdk:"problems"`
+}
+
+func (d *GrantValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg grantValidationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := grantModel{
+		GranteeName:     cfg.GranteeName,
+		PrivilegeType:   cfg.PrivilegeType,
+		Privilege:       cfg.Privilege,
+		ObjectType:      cfg.ObjectType,
+		ObjectName:      cfg.ObjectName,
+		WithAdminOption: cfg.WithAdminOption,
+	}
+	problems, err := d.grantProblems(ctx, m)
+	if err != nil {
+		resp.Diagnostics.AddError("Grant validation failed", err.Error())
+		return
+	}
+
+	cfg.ID = types.StringValue(idForGrant(m, d.quoteIdentifiers))
+	cfg.Valid = types.BoolValue(len(problems) == 0)
+	cfg.Problems = make([]types.String, len(problems))
+	for i, p := range problems {
+		cfg.Problems[i] = types.StringValue(p)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// grantProblems lists why the grant would fail. Query errors are returned as err; a missing
+// grantee, role or object is a problem, not an error.
+func (d *GrantValidationDataSource) grantProblems(ctx context.Context, m grantModel) ([]string, error) {
+	var problems []string
+	grantee := strings.ToUpper(m.GranteeName.ValueString())
+	withAdmin := m.WithAdminOption.ValueBool()
+
+	if isPublicGrantee(grantee) {
+		if withAdmin {
+			problems = append(problems, "PUBLIC cannot receive ADMIN OPTION.")
+		}
+	} else if !isValidIdentifier(grantee) {
+		problems = append(problems, fmt.Sprintf("Grantee name %q contains invalid characters.", m.GranteeName.ValueString()))
+	} else if ok, err := d.exists(ctx, granteeExists, grantee); err != nil {
+		return nil, err
+	} else if !ok {
+		problems = append(problems, fmt.Sprintf("Grantee %s does not exist as a user or role.", grantee))
+	}
+
+	if role, ok := grantedRole(m); ok {
+		found, err := d.exists(ctx, func(ctx context.Context, db *sql.DB, role string) (bool, error) {
+			return grantObjectExists(ctx, db, "ROLE", role)
+		}, role)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("Role %s does not exist.", role))
+		}
+		return problems, nil
+	}
+
+	privilege := normalizePrivilege(m.Privilege.ValueString())
+	if !isValidPrivilegeName(privilege) {
+		return append(problems, fmt.Sprintf("Privilege %q is not a valid privilege name.", m.Privilege.ValueString())), nil
+	}
+
+	switch strings.ToUpper(m.PrivilegeType.ValueString()) {
+	case "SYSTEM":
+		if !isKnownSystemPrivilege(d.serverVersion, privilege) {
+			problems = append(problems, fmt.Sprintf("%s is not a known system privilege on Exasol %d.", privilege, d.serverVersion))
+		}
+	case "OBJECT":
+		if m.ObjectType.IsNull() || m.ObjectName.IsNull() {
+			return append(problems, "object_type and object_name are required for OBJECT privileges."), nil
+		}
+		objectType := strings.ToUpper(m.ObjectType.ValueString())
+		if withAdmin {
+			problems = append(problems, "Object privileges cannot be granted WITH ADMIN OPTION.")
+		}
+		if !isKnownObjectType(d.serverVersion, objectType) {
+			problems = append(problems, fmt.Sprintf("%s is not a known object type on Exasol %d.", objectType, d.serverVersion))
+		} else if !isObjectPrivilegeFor(objectType, privilege) {
+			problems = append(problems, fmt.Sprintf("%s cannot be granted on a %s.", privilege, objectType))
+		}
+		objectName := canonicalQualifiedIdent(m.ObjectName.ValueString(), d.quoteIdentifiers)
+		found, err := d.exists(ctx, func(ctx context.Context, db *sql.DB, name string) (bool, error) {
+			return grantObjectExists(ctx, db, objectType, name)
+		}, objectName)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s %s does not exist.", objectType, objectName))
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("privilege_type must be SYSTEM or OBJECT, got %q.", m.PrivilegeType.ValueString()))
+	}
+	return problems, nil
+}
+
+// exists runs an existence check with the usual read retries.
+func (d *GrantValidationDataSource) exists(ctx context.Context, check func(context.Context, *sql.DB, string) (bool, error), name string) (bool, error) {
+	var found bool
+	err := retryRead(ctx, func() error {
+		var err error
+		found, err = check(ctx, d.db, name)
+		return err
+	})
+	return found, err
+}
+
+// grantObjectExists reports whether the object a privilege would be granted on exists. name is
+// SCHEMA or SCHEMA.OBJECT for schema objects and the connection or role name for connections
+// and role grants.
+func grantObjectExists(ctx context.Context, db *sql.DB, objectType, name string) (bool, error) {
+	var n int
+	var err error
+	switch schemaName, objectName, qualified := strings.Cut(name, "."); {
+	case objectType == "ROLE":
+		err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM EXA_ALL_ROLES WHERE ROLE_NAME = ?`, name).Scan(&n)
+	case objectType == "CONNECTION":
+		err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM EXA_DBA_CONNECTIONS WHERE CONNECTION_NAME = ?`, name).Scan(&n)
+	case objectType == "SCHEMA":
+		err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM EXA_ALL_SCHEMAS WHERE SCHEMA_NAME = ?`, name).Scan(&n)
+	case !qualified:
+		return false, nil
+	default:
+		err = db.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM EXA_ALL_OBJECTS WHERE ROOT_NAME = ? AND OBJECT_NAME = ? AND OBJECT_TYPE = ?`,
+			schemaName, objectName, objectType).Scan(&n)
+	}
+	return n > 0, err
+}
@@ -1,27 +1,227 @@
 package resources
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func qualify(obj string) string {
 	// Allow user to pass SCHEMA.TABLE or just SCHEMA.
 	// We quote identifiers but keep dots as separators.
-	// Also validate each part to prevent SQL injection.
+	// Every part is escaped, so embedded double quotes cannot end the identifier early.
 	parts := strings.Split(obj, ".")
 	for i, p := range parts {
-		// Remove existing quotes if any
-		cleaned := strings.Trim(p, `"`)
-
-		// Validate the identifier
-		if !isValidIdentifier(cleaned) {
-			// If validation fails, still escape it to prevent SQL injection
-			// but don't panic - let the database return an error
-			cleaned = escapeIdentifierLiteral(cleaned)
+		parts[i] = fmt.Sprintf(`"%s"`, escapeIdentifierLiteral(unquoteIdent(p)))
+	}
+	return strings.Join(parts, ".")
+}
+
+// unquoteIdent strips the double quotes a user may have written around an identifier part and
+// undoes their "" escapes. Parts that are not wrapped in quotes are returned unchanged.
+func unquoteIdent(p string) string {
+	if len(p) >= 2 && strings.HasPrefix(p, `"`) && strings.HasSuffix(p, `"`) {
+		return strings.ReplaceAll(p[1:len(p)-1], `""`, `"`)
+	}
+	return p
+}
+
+// quoteIdent renders a single identifier according to the provider's quoting mode.
+// Quoted mode wraps the name in double quotes, so its case is preserved.
+// Unquoted mode emits the name bare and lets Exasol fold it to uppercase, which
+// is only safe for regular identifiers - anything else is rejected.
+func quoteIdent(name string, quoted bool) (string, error) {
+	if quoted {
+		if !isValidIdentifier(name) {
+			return "", fmt.Errorf("identifier must not be empty")
 		}
+		return fmt.Sprintf(`"%s"`, escapeIdentifierLiteral(name)), nil
+	}
+	if !isRegularIdentifier(name) {
+		return "", fmt.Errorf("identifier %q requires quoting: with quote_identifiers = false, "+
+			"names must start with a letter and contain only letters, digits, and underscores", name)
+	}
+	return name, nil
+}
 
-		parts[i] = fmt.Sprintf(`"%s"`, cleaned)
+// qualifyIdent is the mode-aware counterpart of qualify for SCHEMA.OBJECT names.
+func qualifyIdent(obj string, quoted bool) (string, error) {
+	if quoted {
+		return qualify(obj), nil
+	}
+	parts := strings.Split(obj, ".")
+	for i, p := range parts {
+		q, err := quoteIdent(p, false)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = q
+	}
+	return strings.Join(parts, "."), nil
+}
+
+// canonicalIdent returns the name as Exasol stores it: unchanged when quoted,
+// uppercase when unquoted (Exasol folds regular identifiers).
+func canonicalIdent(name string, quoted bool) string {
+	if quoted {
+		return name
+	}
+	return strings.ToUpper(name)
+}
+
+// reconcileName applies the naming invariant of the name-bearing resources (schema, role, user,
+// connection): id holds the name as Exasol stores it, name keeps the configured spelling. name is
+// replaced by the stored name only when it is unset (after import) or no longer maps onto it.
+func reconcileName(name types.String, stored string, canonical func(string) string) types.String {
+	if name.IsNull() || name.IsUnknown() || canonical(name.ValueString()) != stored {
+		return types.StringValue(stored)
+	}
+	return name
+}
+
+// canonicalQualifiedIdent applies canonicalIdent to each part of a SCHEMA.OBJECT name,
+// unquoting a part the user wrote in double quotes with unquoteIdent, like qualify does.
+func canonicalQualifiedIdent(obj string, quoted bool) string {
+	parts := strings.Split(obj, ".")
+	for i, p := range parts {
+		parts[i] = canonicalIdent(unquoteIdent(p), quoted)
 	}
 	return strings.Join(parts, ".")
 }
+
+// nullableString converts a nullable database column into a Terraform string.
+func nullableString(s sql.NullString) types.String {
+	if !s.Valid {
+		return types.StringNull()
+	}
+	return types.StringValue(s.String)
+}
+
+// parseAdminOption interprets an ADMIN_OPTION column value from the EXA_*_PRIVS views.
+// Scan the column into an `any`: depending on server and driver version it arrives as a string
+// (SaaS: "TRUE"/"1", Docker: "true"), a real boolean, a number, or NULL (no admin option).
+func parseAdminOption(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		v = strings.TrimSpace(v)
+		return strings.EqualFold(v, "TRUE") || v == "1"
+	case []byte:
+		return parseAdminOption(string(v))
+	case int64:
+		return v != 0
+	case float64:
+		return v != 0
+	default:
+		return parseAdminOption(fmt.Sprint(v))
+	}
+}
+
+// resolvedName returns the uppercase form used in synthetic IDs and metadata view lookups.
+func resolvedName(v types.String) types.String {
+	return types.StringValue(strings.ToUpper(v.ValueString()))
+}
+
+// withTimeout bounds ctx by d. A zero d leaves ctx unbounded.
+func withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+// acquireOperation takes a max_concurrent_operations slot for a Create/Update/Delete. Call it
+// after withTimeout so waiting counts against the operation timeout, and defer the returned release.
+func acquireOperation(ctx context.Context, l *exasolclient.OperationLimiter, diags *diag.Diagnostics) (func(), bool) {
+	if err := l.Acquire(ctx); err != nil {
+		diags.AddError("Timed out waiting for an operation slot",
+			fmt.Sprintf("max_concurrent_operations slots stayed busy until the operation was cancelled: %v", err))
+		return nil, false
+	}
+	return l.Release, true
+}
+
+// reportDeleteTimeout explains a failed Delete whose context hit the delete timeout, including
+// time spent waiting for an operation slot or retrying collisions. Call it deferred, after defer cancel().
+func reportDeleteTimeout(ctx context.Context, diags *diag.Diagnostics, d time.Duration) {
+	if !diags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return
+	}
+	diags.AddError("Delete timed out",
+		fmt.Sprintf("The statement was cancelled after %s (delete_timeout_seconds, or query_timeout_seconds if unset). "+
+			"The object may still exist; "+
+			"check for long-running sessions or locks and run the destroy again.", d))
+}
+
+// currentUser returns the provider's session user.
+func currentUser(ctx context.Context, db *sql.DB) (string, error) {
+	var user string
+	err := db.QueryRowContext(ctx, `SELECT CURRENT_USER`).Scan(&user)
+	return user, err
+}
+
+// warnForeignGrantors warns before a REVOKE of an object privilege that none of its grantors is
+// the provider user. Exasol only lets the grantor, or a user with GRANT ANY OBJECT PRIVILEGE or
+// GRANT ANY PRIVILEGE, revoke it, so the REVOKE may fail. Unknown grantors produce no warning.
+func warnForeignGrantors(ctx context.Context, db *sql.DB, diags *diag.Diagnostics, what string, grantors []string) {
+	if len(grantors) == 0 {
+		return
+	}
+	current, err := currentUser(ctx, db)
+	if err != nil {
+		return
+	}
+	for _, g := range grantors {
+		if strings.EqualFold(g, current) {
+			return
+		}
+	}
+	diags.AddWarning("Grant made by another user",
+		fmt.Sprintf("%s was granted by %s, not by the provider user %s. The REVOKE fails unless %s holds "+
+			"GRANT ANY OBJECT PRIVILEGE or GRANT ANY PRIVILEGE.", what, strings.Join(grantors, ", "), current, current))
+}
+
+// publicAdminOptionDetail explains why PUBLIC cannot receive a grant WITH ADMIN OPTION.
+const publicAdminOptionDetail = "Exasol does not allow granting to PUBLIC WITH ADMIN OPTION and reports an opaque error. " +
+	"Drop with_admin_option or grant to a specific user or role instead."
+
+// isPublicGrantee reports whether grantee names the PUBLIC pseudo-role.
+func isPublicGrantee(grantee string) bool {
+	return strings.EqualFold(strings.TrimSpace(grantee), "PUBLIC")
+}
+
+// rejectPublicAdminOption adds an error when grantee is PUBLIC and withAdmin is true.
+// Unknown values are skipped; they are checked again once known.
+func rejectPublicAdminOption(diags *diag.Diagnostics, granteePath path.Path, grantee types.String, withAdmin types.Bool) {
+	if grantee.IsNull() || grantee.IsUnknown() || withAdmin.IsUnknown() {
+		return
+	}
+	if isPublicGrantee(grantee.ValueString()) && withAdmin.ValueBool() {
+		diags.AddAttributeError(granteePath, "PUBLIC cannot receive ADMIN OPTION", publicAdminOptionDetail)
+	}
+}
+
+// granteeExists reports whether a user or role with the given name exists. EXA_ALL_USERS and
+// EXA_ALL_ROLES list every user and role regardless of the caller's privileges.
+func granteeExists(ctx context.Context, db *sql.DB, grantee string) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx,
+		`SELECT (SELECT COUNT(*) FROM EXA_ALL_USERS WHERE USER_NAME = ?) + `+
+			`(SELECT COUNT(*) FROM EXA_ALL_ROLES WHERE ROLE_NAME = ?)`, grantee, grantee).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
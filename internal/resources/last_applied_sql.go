@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// lastAppliedSQLAttribute is the computed last_applied_sql attribute shared by all mutating resources.
+func lastAppliedSQLAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		Description: "Statements executed by the last create or update, separated by semicolons. " +
+			"Passwords and other secrets are redacted.",
+		PlanModifiers: []planmodifier.String{lastAppliedSQLUseState{}},
+	}
+}
+
+// noSQLAttributes are attributes whose change makes Update run no statements: they only steer
+// the provider (validation, drift handling, destroy behaviour).
+var noSQLAttributes = map[string]bool{
+	"acknowledge_broad_privilege": true,
+	"cascade":                     true,
+	"check_connection_access":     true,
+	"ignore_to_drift":             true,
+	"protected":                   true,
+	"verify_after_create":         true,
+	"verify_rename":               true,
+	"last_applied_sql":            true,
+}
+
+// lastAppliedSQLUseState is UseStateForUnknown limited to updates that run no statements. Update
+// replaces last_applied_sql whenever it executes SQL, and a planned known value that changes on
+// apply is an error in Terraform, so the prior value is only kept when every attribute that
+// changed is in noSQLAttributes and the others are unknown only because they are computed.
+type lastAppliedSQLUseState struct{}
+
+func (m lastAppliedSQLUseState) Description(_ context.Context) string {
+	return "Keeps the prior value when the update runs no statements."
+}
+
+func (m lastAppliedSQLUseState) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m lastAppliedSQLUseState) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state, config map[string]tftypes.Value
+	if req.Plan.Raw.As(&plan) != nil || req.State.Raw.As(&state) != nil || req.Config.Raw.As(&config) != nil {
+		return
+	}
+	for name, planned := range plan {
+		if noSQLAttributes[name] {
+			continue
+		}
+		if c, ok := config[name]; ok && !c.IsFullyKnown() {
+			return
+		}
+		if !planned.IsFullyKnown() {
+			continue
+		}
+		if !planned.Equal(state[name]) {
+			return
+		}
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// lastAppliedSQL renders the statements collected by rec. When nothing was executed
+// (e.g. an update that only touched provider-side attributes) the prior value is kept.
+func lastAppliedSQL(rec *exasolclient.StatementRecorder, prior types.String) types.String {
+	stmts := rec.Statements()
+	if len(stmts) == 0 {
+		if prior.IsUnknown() {
+			return types.StringNull()
+		}
+		return prior
+	}
+	return types.StringValue(strings.Join(stmts, ";\n"))
+}
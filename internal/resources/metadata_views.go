@@ -0,0 +1,81 @@
+package resources
+
+// Metadata view scopes select which family of Exasol system views Read operations query.
+// DBA views need SELECT ANY DICTIONARY; ALL/USER views let a non-DBA provider user
+// manage objects it owns or has been granted.
+const (
+	ViewScopeDBA  = "DBA"
+	ViewScopeAll  = "ALL"
+	ViewScopeUser = "USER"
+)
+
+// objPrivsView returns the object privilege view for the scope.
+func objPrivsView(scope string) string {
+	switch scope {
+	case ViewScopeAll:
+		return "EXA_ALL_OBJ_PRIVS"
+	case ViewScopeUser:
+		return "EXA_USER_OBJ_PRIVS"
+	default:
+		return "EXA_DBA_OBJ_PRIVS"
+	}
+}
+
+// rolePrivsView returns the role grant view for the scope. Exasol has no EXA_ALL_ROLE_PRIVS,
+// so the ALL scope combines roles granted to the current user and to its roles.
+func rolePrivsView(scope string) string {
+	switch scope {
+	case ViewScopeAll:
+		return "(SELECT GRANTEE, GRANTED_ROLE, ADMIN_OPTION FROM EXA_USER_ROLE_PRIVS " +
+			"UNION ALL SELECT GRANTEE, GRANTED_ROLE, ADMIN_OPTION FROM EXA_ROLE_ROLE_PRIVS) RP"
+	case ViewScopeUser:
+		return "EXA_USER_ROLE_PRIVS"
+	default:
+		return "EXA_DBA_ROLE_PRIVS"
+	}
+}
+
+// sysPrivsView returns the system privilege view for the scope. Like rolePrivsView, the ALL
+// scope combines privileges granted to the current user and to its roles.
+func sysPrivsView(scope string) string {
+	switch scope {
+	case ViewScopeAll:
+		return "(SELECT GRANTEE, PRIVILEGE, ADMIN_OPTION FROM EXA_USER_SYS_PRIVS " +
+			"UNION ALL SELECT GRANTEE, PRIVILEGE, ADMIN_OPTION FROM EXA_ROLE_SYS_PRIVS) SP"
+	case ViewScopeUser:
+		return "EXA_USER_SYS_PRIVS"
+	default:
+		return "EXA_DBA_SYS_PRIVS"
+	}
+}
+
+// connectionPrivsView returns the connection grant view for the scope, combined like sysPrivsView.
+func connectionPrivsView(scope string) string {
+	switch scope {
+	case ViewScopeAll:
+		return "(SELECT GRANTEE, GRANTED_CONNECTION, ADMIN_OPTION FROM EXA_USER_CONNECTION_PRIVS " +
+			"UNION ALL SELECT GRANTEE, GRANTED_CONNECTION, ADMIN_OPTION FROM EXA_ROLE_CONNECTION_PRIVS) CP"
+	case ViewScopeUser:
+		return "EXA_USER_CONNECTION_PRIVS"
+	default:
+		return "EXA_DBA_CONNECTION_PRIVS"
+	}
+}
+
+// rolesView returns the role catalog view for the scope.
+func rolesView(scope string) string {
+	if scope == ViewScopeAll || scope == ViewScopeUser {
+		return "EXA_ALL_ROLES"
+	}
+	return "EXA_DBA_ROLES"
+}
+
+// ScopedMetadataViews returns the object privilege, role grant and role views Read queries under
+// scope, so the provider can check at configure time that they are accessible.
+func ScopedMetadataViews(scope string) (objPrivs string, rolePrivs []string, roles string) {
+	rolePrivs = []string{rolePrivsView(scope)}
+	if scope == ViewScopeAll {
+		rolePrivs = []string{"EXA_USER_ROLE_PRIVS", "EXA_ROLE_ROLE_PRIVS"}
+	}
+	return objPrivsView(scope), rolePrivs, rolesView(scope)
+}
@@ -4,26 +4,40 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"sort"
+	"slices"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ObjectPrivilegeResource{}
 var _ resource.ResourceWithImportState = &ObjectPrivilegeResource{}
+var _ resource.ResourceWithValidateConfig = &ObjectPrivilegeResource{}
 
 // ObjectPrivilegeResource manages Exasol object privileges.
 // Object privileges are granted on schemas, tables, views, scripts, etc.
 type ObjectPrivilegeResource struct {
-	db *sql.DB
+	db                    *sql.DB
+	quoteIdentifiers      bool
+	viewScope             string
+	queryTimeout          time.Duration
+	readTimeout           time.Duration
+	deleteTimeout         time.Duration
+	collisionRetries      int
+	ignoreMissingOnRevoke bool
+	readPermissionPolicy  string
+	operations            *exasolclient.OperationLimiter
+	serverVersion         int
 }
 
 func NewObjectPrivilegeResource() resource.Resource {
@@ -53,12 +67,72 @@ func (r *ObjectPrivilegeResource) Schema(_ context.Context, _ resource.SchemaReq
 			},
 			"object_type": schema.StringAttribute{
 				Required:    true,
-				Description: "Object type: SCHEMA, TABLE, VIEW, SCRIPT, FUNCTION, etc.",
+				Description: "Object type: SCHEMA, TABLE, VIEW, SCRIPT, FUNCTION or CONNECTION.",
+				Validators: []validator.String{
+					objectTypeValidator{
+						allowed: supportedObjectTypes,
+						// Unlike the legacy exasol_grant, this resource does not overload object_type = ROLE for role grants
+						hints: map[string]string{
+							"ROLE": "Roles are not objects; use the exasol_role_grant resource to grant a role to a user or role.",
+						},
+					},
+				},
 			},
 			"object_name": schema.StringAttribute{
-				Required:    true,
-				Description: "Qualified object name (e.g., 'MYSCHEMA' for schema, 'MYSCHEMA.MYTABLE' for table).",
+				Required: true,
+				Description: "Qualified object name (e.g., 'MYSCHEMA' for schema, 'MYSCHEMA.MYTABLE' for table). " +
+					"Kept as written; the ID and resolved_object_name use the name as Exasol stores it. " +
+					"For object_type TABLE or VIEW, 'MYSCHEMA.*' grants on every table (or view) of the schema: " +
+					"the wildcard is expanded when applied, so objects created later are only covered after the next update.",
+			},
+			"expanded_objects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Objects a wildcard object_name was expanded to at the last apply. Null for a plain object_name.",
+			},
+			"expand_all": schema.BoolAttribute{
+				Optional: true,
+				Description: "When privileges contains ALL, record the individual privileges Exasol expanded ALL to in expanded_privileges " +
+					"and check each of them on refresh, so revoking a single one (e.g. DELETE) shows up as drift and the next apply grants ALL again. " +
+					"Default false (ALL counts as present while any privilege on the object remains).",
+			},
+			"expanded_privileges": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Privileges ALL was expanded to at the last apply when expand_all is set. Null otherwise.",
+			},
+			"object_storage_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Object type Exasol records the grant under, used when reading it back: AUTO (default), TABLE or VIEW. " +
+					"AUTO lets TABLE and VIEW match each other because some versions store view grants as TABLE; " +
+					"set TABLE or VIEW to match exactly what your version records. Only valid for object_type TABLE or VIEW.",
+			},
+			"resolved_grantee": schema.StringAttribute{
+				Computed:    true,
+				Description: "Grantee name as stored in Exasol.",
+			},
+			"resolved_object_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object type as stored in Exasol.",
+			},
+			"resolved_object_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object name as stored in Exasol.",
 			},
+			"acknowledge_broad_privilege": acknowledgeBroadPrivilegeAttribute(),
+			"manage_exclusive": schema.BoolAttribute{
+				Optional: true,
+				Description: "Treat privileges as the complete set the grantee holds on the object: privileges granted " +
+					"outside Terraform show up as drift and are revoked on the next apply. Default false (only the listed privileges are managed).",
+			},
+			"grantors": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Users that granted the grantee privileges on the object, from the GRANTOR column of the object privilege view. " +
+					"Several grantors can grant the same privilege; a REVOKE by the provider user may then fail or leave another grantor's grant in place.",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Terraform ID in format: GRANTEE|PRIVILEGES|OBJECT_TYPE|OBJECT_NAME",
@@ -73,18 +147,112 @@ func (r *ObjectPrivilegeResource) Configure(_ context.Context, req resource.Conf
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.ignoreMissingOnRevoke = c.IgnoreMissingOnRevoke
+		r.operations = c.Operations
+		r.serverVersion = c.ServerMajorVersion
 	}
 }
 
 type objectPrivilegeModel struct {
-	ID         types.String `tfsdk:"id"`
-	Grantee    types.String `tfsdk:"grantee"`
-	Privileges types.List   `tfsdk:"privileges"`
-	ObjectType types.String `tfsdk:"object_type"`
-	ObjectName types.String `tfsdk:"object_name"`
+	ID                 types.String `tfsdk:"id"`
+	Grantee            types.String `tfsdk:"grantee"`
+	Privileges         types.List   `tfsdk:"privileges"`
+	ObjectType         types.String `tfsdk:"object_type"`
+	ObjectName         types.String `tfsdk:"object_name"`
+	ObjectStorageType  types.String `tfsdk:"object_storage_type"`
+	ManageExclusive    types.Bool   `tfsdk:"manage_exclusive"`
+	AcknowledgeBroad   types.Bool   `tfsdk:"acknowledge_broad_privilege"`
+	ResolvedGrantee    types.String `tfsdk:"resolved_grantee"`
+	ResolvedObjectType types.String `tfsdk:"resolved_object_type"`
+	ResolvedObjectName types.String `tfsdk:"resolved_object_name"`
+	Grantors           types.List   `tfsdk:"grantors"`
+	ExpandedObjects    types.List   `tfsdk:"expanded_objects"`
+	ExpandAll          types.Bool   `tfsdk:"expand_all"`
+	ExpandedPrivileges types.List   `tfsdk:"expanded_privileges"`
+	Protected          types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL     types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *ObjectPrivilegeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg objectPrivilegeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !cfg.Privileges.IsNull() && !cfg.Privileges.IsUnknown() {
+		for _, e := range cfg.Privileges.Elements() {
+			p, ok := e.(types.String)
+			if !ok || p.IsNull() || p.IsUnknown() {
+				continue
+			}
+			if !isValidPrivilegeName(normalizePrivilege(p.ValueString())) {
+				resp.Diagnostics.AddAttributeError(path.Root("privileges"), "Invalid privilege",
+					fmt.Sprintf("Privilege %q must consist of words of letters separated by spaces, e.g. SELECT or CREATE TABLE.", p.ValueString()))
+			}
+		}
+	}
+	if !cfg.Privileges.IsNull() && !cfg.Privileges.IsUnknown() {
+		var privileges []string
+		for _, e := range cfg.Privileges.Elements() {
+			if p, ok := e.(types.String); ok && !p.IsNull() && !p.IsUnknown() {
+				privileges = append(privileges, p.ValueString())
+				warnBroadPrivilege(&resp.Diagnostics, path.Root("privileges"), p, cfg.AcknowledgeBroad)
+			}
+		}
+		for _, dup := range normalizedDuplicates(privileges, normalizePrivilege) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("privileges"), "Duplicate privilege",
+				fmt.Sprintf("Privilege %q is listed more than once (privileges are compared case-insensitively).", dup))
+		}
+	}
+	if _, ok := wildcardSchema(cfg.ObjectName.ValueString()); ok && !cfg.ObjectType.IsUnknown() {
+		if t := strings.ToUpper(cfg.ObjectType.ValueString()); t != "TABLE" && t != "VIEW" {
+			resp.Diagnostics.AddAttributeError(path.Root("object_name"), "Wildcard not supported",
+				fmt.Sprintf("A wildcard object_name (SCHEMA.*) requires object_type TABLE or VIEW, not %q.", t))
+		}
+		if cfg.ManageExclusive.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("manage_exclusive"), "Wildcard not supported",
+				"manage_exclusive cannot be combined with a wildcard object_name.")
+		}
+		if cfg.ExpandAll.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("expand_all"), "Wildcard not supported",
+				"expand_all cannot be combined with a wildcard object_name.")
+		}
+	}
+	if !cfg.ObjectStorageType.IsNull() && !cfg.ObjectStorageType.IsUnknown() {
+		switch strings.ToUpper(cfg.ObjectStorageType.ValueString()) {
+		case "AUTO", "TABLE", "VIEW":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("object_storage_type"), "Invalid object_storage_type",
+				fmt.Sprintf("object_storage_type must be AUTO, TABLE or VIEW, got %q.", cfg.ObjectStorageType.ValueString()))
+			return
+		}
+		if !cfg.ObjectType.IsUnknown() {
+			if t := strings.ToUpper(cfg.ObjectType.ValueString()); t != "TABLE" && t != "VIEW" {
+				resp.Diagnostics.AddAttributeError(path.Root("object_storage_type"), "object_storage_type not applicable",
+					fmt.Sprintf("object_storage_type only applies to object_type TABLE or VIEW, not %q.", t))
+			}
+		}
+	}
 }
 
 func (r *ObjectPrivilegeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_object_privilege")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan objectPrivilegeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -95,14 +263,43 @@ func (r *ObjectPrivilegeResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	grantee := strings.ToUpper(plan.Grantee.ValueString())
+	grantee, err := quoteIdent(strings.ToUpper(plan.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
 	objectType := strings.ToUpper(plan.ObjectType.ValueString())
-	objectName := qualify(plan.ObjectName.ValueString())
+	warnUnknownObjectType(&resp.Diagnostics, r.serverVersion, objectType)
 
-	// Validate identifiers
-	if !isValidIdentifier(grantee) {
-		resp.Diagnostics.AddError("Invalid grantee", "Grantee name contains invalid characters")
-		return
+	// A wildcard is granted on each object of the schema, a plain name on itself. objects holds
+	// the same objects in the spelling the privilege views report, for the existence check.
+	var objectNames, objects []string
+	plan.ExpandedObjects = types.ListNull(types.StringType)
+	if schemaName, ok := wildcardSchema(plan.ObjectName.ValueString()); ok {
+		objects, err = r.expandWildcard(ctx, objectType, schemaName)
+		if err != nil {
+			resp.Diagnostics.AddError("Expand wildcard object name failed", err.Error())
+			return
+		}
+		for _, o := range objects {
+			name, err := quoteExpandedObject(o)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid object name", err.Error())
+				return
+			}
+			objectNames = append(objectNames, name)
+		}
+		list, diags := types.ListValueFrom(ctx, types.StringType, objects)
+		resp.Diagnostics.Append(diags...)
+		plan.ExpandedObjects = list
+	} else {
+		objectName, err := qualifyIdent(plan.ObjectName.ValueString(), r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid object name", err.Error())
+			return
+		}
+		objectNames = []string{objectName}
+		objects = []string{canonicalQualifiedIdent(plan.ObjectName.ValueString(), r.quoteIdentifiers)}
 	}
 
 	// Extract privileges from list
@@ -112,22 +309,72 @@ func (r *ObjectPrivilegeResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Grant each privilege
+	// Privileges the grantee already holds are skipped, so a Create retried after a partial
+	// failure does not fail on re-granting them
+	held := make([]map[string]bool, len(objects))
+	var skipped []string
+	for i, object := range objects {
+		held[i] = r.heldObjectPrivileges(ctx, plan, objectType, object)
+		for _, privilege := range privileges {
+			if priv := normalizePrivilege(privilege); held[i][priv] {
+				skipped = append(skipped, fmt.Sprintf("%s ON %s", priv, object))
+			}
+		}
+	}
+	if len(skipped) > 0 {
+		resp.Diagnostics.AddWarning("Privileges already granted",
+			fmt.Sprintf("%s already holds %s. They are not granted again, but are managed by this resource and revoked on destroy.",
+				grantee, strings.Join(skipped, ", ")))
+	}
+
+	// A GRANT failing part-way revokes the grants made so far. The rollback runs on its own
+	// context, as ctx may just have hit query_timeout.
+	var granted []string
+	rollback := func() {
+		rctx, cancel := withTimeout(context.WithoutCancel(ctx), r.deleteTimeout)
+		defer cancel()
+		for _, stmt := range granted {
+			if err := execRevoke(rctx, r.db, r.collisionRetries, stmt, true); err != nil {
+				tflog.Warn(ctx, "Rolling back GRANT failed", map[string]any{"sql": stmt, "error": err.Error()})
+			}
+		}
+	}
 	for _, privilege := range privileges {
-		priv := strings.ToUpper(privilege)
-		stmt := fmt.Sprintf(`GRANT %s ON %s %s TO "%s"`, priv, objectType, objectName, grantee)
-		tflog.Info(ctx, "Granting object privilege", map[string]any{"sql": stmt})
-		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("GRANT %s failed", priv), err.Error())
-			return
+		priv := normalizePrivilege(privilege)
+		for i, objectName := range objectNames {
+			if held[i][priv] {
+				continue
+			}
+			stmt := fmt.Sprintf(`GRANT %s ON %s %s TO %s`, priv, objectType, objectName, grantee)
+			tflog.Info(ctx, "Granting object privilege", map[string]any{"sql": stmt})
+			if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+				rollback()
+				if isSystemObject(plan.ObjectName.ValueString()) && isSystemObjectGrantError(err) {
+					resp.Diagnostics.AddAttributeError(path.Root("object_name"), fmt.Sprintf("GRANT %s failed", priv),
+						fmt.Sprintf("%s\n\nExasol does not allow object privileges on the system schemas SYS and EXA_STATISTICS. "+
+							"To give %s read access to the data dictionary, grant the system privilege SELECT ANY DICTIONARY "+
+							"with exasol_system_privilege instead.", err.Error(), grantee))
+					return
+				}
+				resp.Diagnostics.AddError(fmt.Sprintf("GRANT %s failed", priv), err.Error())
+				return
+			}
+			granted = append(granted, fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, priv, objectType, objectName, grantee))
 		}
 	}
 
-	plan.ID = types.StringValue(objectPrivilegeID(plan))
+	plan.ID = types.StringValue(objectPrivilegeID(plan, r.quoteIdentifiers))
+	setObjectPrivilegeResolved(&plan, r.quoteIdentifiers)
+	r.setGrantors(ctx, &plan)
+	r.setExpandedPrivileges(ctx, &plan, &resp.Diagnostics)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ObjectPrivilegeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -141,7 +388,8 @@ func (r *ObjectPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 
 	grantee := strings.ToUpper(state.Grantee.ValueString())
 	objectType := strings.ToUpper(state.ObjectType.ValueString())
-	objectName := strings.ToUpper(state.ObjectName.ValueString())
+	objectName := canonicalQualifiedIdent(state.ObjectName.ValueString(), r.quoteIdentifiers)
+	storageType := strings.ToUpper(state.ObjectStorageType.ValueString())
 
 	// Extract privileges from list
 	var privileges []string
@@ -150,17 +398,63 @@ func (r *ObjectPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// An import with "*" takes the privilege list from the database instead of the import ID
+	if len(privileges) == 1 && privileges[0] == importAllPrivileges {
+		var granted []string
+		err := retryRead(ctx, func() error {
+			var err error
+			granted, err = readObjectPrivileges(ctx, r.db, r.viewScope, grantee, objectType, storageType, objectName)
+			return err
+		})
+		if err != nil {
+			readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read object privilege failed", err)
+			return
+		}
+		privileges = granted
+	}
+
+	// A wildcard is checked on every object it was expanded to (expanded now after an import).
+	// A privilege missing on any of them is reported as drift, so the next apply grants it again.
+	checkNames := []string{objectName}
+	if schemaName, ok := wildcardSchema(state.ObjectName.ValueString()); ok {
+		if state.ExpandedObjects.IsNull() {
+			objects, err := r.expandWildcard(ctx, objectType, schemaName)
+			if err != nil {
+				readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Expand wildcard object name failed", err)
+				return
+			}
+			list, diags := types.ListValueFrom(ctx, types.StringType, objects)
+			resp.Diagnostics.Append(diags...)
+			state.ExpandedObjects = list
+		}
+		checkNames = expandedObjects(ctx, state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Check if privileges exist
 	var foundPrivileges []string
 	for _, privilege := range privileges {
-		priv := strings.ToUpper(privilege)
-		exists, err := checkObjectPrivilegeExists(ctx, r.db, grantee, priv, objectType, objectName)
-		if err != nil {
-			resp.Diagnostics.AddError("Read object privilege failed", err.Error())
-			return
+		priv := normalizePrivilege(privilege)
+		exists := true
+		for _, name := range checkNames {
+			err := retryRead(ctx, func() error {
+				var err error
+				exists, err = checkObjectPrivilegeExists(ctx, r.db, r.viewScope, grantee, priv, objectType, storageType, name)
+				return err
+			})
+			if err != nil {
+				readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read object privilege failed", err)
+				return
+			}
+			if !exists {
+				break
+			}
 		}
 		if exists {
-			foundPrivileges = append(foundPrivileges, priv)
+			// Keep the configured spelling (e.g. "create table") so only real revocations show as drift
+			foundPrivileges = append(foundPrivileges, privilege)
 		}
 	}
 
@@ -170,6 +464,55 @@ func (r *ObjectPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// With expand_all, ALL only counts as present while every privilege it was expanded to is.
+	// Otherwise ALL is replaced by the privileges still held, so the plan grants ALL again.
+	if state.ExpandAll.ValueBool() && !state.ExpandedPrivileges.IsNull() &&
+		slices.ContainsFunc(foundPrivileges, func(p string) bool { return normalizePrivilege(p) == "ALL" }) {
+		var expanded, granted []string
+		resp.Diagnostics.Append(state.ExpandedPrivileges.ElementsAs(ctx, &expanded, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := retryRead(ctx, func() error {
+			var err error
+			granted, err = readObjectPrivileges(ctx, r.db, r.viewScope, grantee, objectType, storageType, objectName)
+			return err
+		})
+		if err != nil {
+			readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read object privilege failed", err)
+			return
+		}
+		if missing := missingExpandedPrivileges(expanded, granted); len(missing) > 0 {
+			tflog.Warn(ctx, "Privileges expanded from ALL were revoked outside Terraform", map[string]any{"missing": missing})
+			foundPrivileges = replaceAllPrivilege(foundPrivileges, expanded, granted)
+		}
+	}
+
+	// In exclusive mode, privileges granted outside Terraform are added to state so the plan
+	// shows them as drift and Update revokes them. ALL already covers every privilege.
+	managed := make(map[string]bool, len(foundPrivileges))
+	for _, p := range foundPrivileges {
+		managed[normalizePrivilege(p)] = true
+	}
+	if state.ManageExclusive.ValueBool() && !managed["ALL"] {
+		var granted []string
+		err := retryRead(ctx, func() error {
+			var err error
+			granted, err = readObjectPrivileges(ctx, r.db, r.viewScope, grantee, objectType, storageType, objectName)
+			return err
+		})
+		if err != nil {
+			readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read object privilege failed", err)
+			return
+		}
+		for _, p := range granted {
+			if !managed[normalizePrivilege(p)] {
+				foundPrivileges = append(foundPrivileges, p)
+				managed[normalizePrivilege(p)] = true
+			}
+		}
+	}
+
 	// Update state with found privileges (in case some were revoked outside Terraform)
 	privList, diags := types.ListValueFrom(ctx, types.StringType, foundPrivileges)
 	resp.Diagnostics.Append(diags...)
@@ -177,11 +520,23 @@ func (r *ObjectPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	state.Privileges = privList
-	state.ID = types.StringValue(objectPrivilegeID(state))
+	state.ID = types.StringValue(objectPrivilegeID(state, r.quoteIdentifiers))
+	setObjectPrivilegeResolved(&state, r.quoteIdentifiers)
+	r.setGrantors(ctx, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_object_privilege")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state objectPrivilegeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -193,6 +548,22 @@ func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	_, oldWildcard := wildcardSchema(state.ObjectName.ValueString())
+	_, newWildcard := wildcardSchema(plan.ObjectName.ValueString())
+	if oldWildcard || newWildcard {
+		r.updateWildcard(ctx, &plan, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ID = types.StringValue(objectPrivilegeID(plan, r.quoteIdentifiers))
+		setObjectPrivilegeResolved(&plan, r.quoteIdentifiers)
+		r.setGrantors(ctx, &plan)
+		plan.ExpandedPrivileges = types.ListNull(types.StringType)
+		plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Extract old and new privileges
 	var oldPrivileges, newPrivileges []string
 	resp.Diagnostics.Append(state.Privileges.ElementsAs(ctx, &oldPrivileges, false)...)
@@ -201,31 +572,50 @@ func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	oldGrantee := strings.ToUpper(state.Grantee.ValueString())
-	newGrantee := strings.ToUpper(plan.Grantee.ValueString())
+	oldGrantee, err := quoteIdent(strings.ToUpper(state.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
+	newGrantee, err := quoteIdent(strings.ToUpper(plan.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
 	oldObjectType := strings.ToUpper(state.ObjectType.ValueString())
 	newObjectType := strings.ToUpper(plan.ObjectType.ValueString())
-	oldObjectName := qualify(state.ObjectName.ValueString())
-	newObjectName := qualify(plan.ObjectName.ValueString())
+	if newObjectType != oldObjectType {
+		warnUnknownObjectType(&resp.Diagnostics, r.serverVersion, newObjectType)
+	}
+	oldObjectName, err := qualifyIdent(state.ObjectName.ValueString(), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid object name", err.Error())
+		return
+	}
+	newObjectName, err := qualifyIdent(plan.ObjectName.ValueString(), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid object name", err.Error())
+		return
+	}
 
 	// If grantee, object type, or object name changed, revoke all old and grant all new
 	if oldGrantee != newGrantee || oldObjectType != newObjectType || oldObjectName != newObjectName {
 		// Revoke old privileges
 		for _, privilege := range oldPrivileges {
-			priv := strings.ToUpper(privilege)
-			revokeStmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM "%s"`, priv, oldObjectType, oldObjectName, oldGrantee)
+			priv := normalizePrivilege(privilege)
+			revokeStmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, priv, oldObjectType, oldObjectName, oldGrantee)
 			tflog.Info(ctx, "Revoking old object privilege", map[string]any{"sql": revokeStmt})
-			if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+			if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 				tflog.Warn(ctx, "REVOKE failed (privilege may not exist)", map[string]any{"error": err.Error()})
 			}
 		}
 
 		// Grant new privileges
 		for _, privilege := range newPrivileges {
-			priv := strings.ToUpper(privilege)
-			grantStmt := fmt.Sprintf(`GRANT %s ON %s %s TO "%s"`, priv, newObjectType, newObjectName, newGrantee)
+			priv := normalizePrivilege(privilege)
+			grantStmt := fmt.Sprintf(`GRANT %s ON %s %s TO %s`, priv, newObjectType, newObjectName, newGrantee)
 			tflog.Info(ctx, "Granting new object privilege", map[string]any{"sql": grantStmt})
-			if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+			if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 				resp.Diagnostics.AddError(fmt.Sprintf("GRANT %s failed", priv), err.Error())
 				return
 			}
@@ -234,19 +624,19 @@ func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		// Only privileges changed - calculate diff
 		oldPrivSet := make(map[string]bool)
 		for _, p := range oldPrivileges {
-			oldPrivSet[strings.ToUpper(p)] = true
+			oldPrivSet[normalizePrivilege(p)] = true
 		}
 		newPrivSet := make(map[string]bool)
 		for _, p := range newPrivileges {
-			newPrivSet[strings.ToUpper(p)] = true
+			newPrivSet[normalizePrivilege(p)] = true
 		}
 
 		// Revoke privileges that are no longer in the list
 		for priv := range oldPrivSet {
 			if !newPrivSet[priv] {
-				revokeStmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM "%s"`, priv, newObjectType, newObjectName, newGrantee)
+				revokeStmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, priv, newObjectType, newObjectName, newGrantee)
 				tflog.Info(ctx, "Revoking removed privilege", map[string]any{"sql": revokeStmt})
-				if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+				if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 					tflog.Warn(ctx, "REVOKE failed (privilege may not exist)", map[string]any{"error": err.Error()})
 				}
 			}
@@ -255,9 +645,9 @@ func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		// Grant new privileges
 		for priv := range newPrivSet {
 			if !oldPrivSet[priv] {
-				grantStmt := fmt.Sprintf(`GRANT %s ON %s %s TO "%s"`, priv, newObjectType, newObjectName, newGrantee)
+				grantStmt := fmt.Sprintf(`GRANT %s ON %s %s TO %s`, priv, newObjectType, newObjectName, newGrantee)
 				tflog.Info(ctx, "Granting new privilege", map[string]any{"sql": grantStmt})
-				if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+				if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 					resp.Diagnostics.AddError(fmt.Sprintf("GRANT %s failed", priv), err.Error())
 					return
 				}
@@ -265,28 +655,65 @@ func (r *ObjectPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
-	plan.ID = types.StringValue(objectPrivilegeID(plan))
+	plan.ID = types.StringValue(objectPrivilegeID(plan, r.quoteIdentifiers))
+	plan.ExpandedObjects = types.ListNull(types.StringType)
+	setObjectPrivilegeResolved(&plan, r.quoteIdentifiers)
+	r.setGrantors(ctx, &plan)
+	r.setExpandedPrivileges(ctx, &plan, &resp.Diagnostics)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ObjectPrivilegeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_object_privilege")
 
 	var state objectPrivilegeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDelete(state.Protected, "exasol_object_privilege "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
 	}
 
-	grantee := strings.ToUpper(state.Grantee.ValueString())
+	grantee, err := quoteIdent(strings.ToUpper(state.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
 	objectType := strings.ToUpper(state.ObjectType.ValueString())
-	objectName := qualify(state.ObjectName.ValueString())
+
+	// A wildcard is revoked from each object it was expanded to
+	var objectNames []string
+	if _, ok := wildcardSchema(state.ObjectName.ValueString()); ok {
+		for _, o := range expandedObjects(ctx, state, &resp.Diagnostics) {
+			name, err := quoteExpandedObject(o)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid object name", err.Error())
+				return
+			}
+			objectNames = append(objectNames, name)
+		}
+	} else {
+		objectName, err := qualifyIdent(state.ObjectName.ValueString(), r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid object name", err.Error())
+			return
+		}
+		objectNames = []string{objectName}
+	}
 
 	// Extract privileges from list
 	var privileges []string
@@ -295,13 +722,22 @@ func (r *ObjectPrivilegeResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if !state.Grantors.IsNull() && !state.Grantors.IsUnknown() {
+		var grantors []string
+		resp.Diagnostics.Append(state.Grantors.ElementsAs(ctx, &grantors, false)...)
+		warnForeignGrantors(ctx, r.db, &resp.Diagnostics,
+			fmt.Sprintf("%s on %s", strings.Join(privileges, ", "), state.ObjectName.ValueString()), grantors)
+	}
+
 	// Revoke each privilege
 	for _, privilege := range privileges {
-		priv := strings.ToUpper(privilege)
-		stmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM "%s"`, priv, objectType, objectName, grantee)
-		tflog.Info(ctx, "Revoking object privilege", map[string]any{"sql": stmt})
-		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("REVOKE %s failed", priv), err.Error())
+		priv := normalizePrivilege(privilege)
+		for _, objectName := range objectNames {
+			stmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, priv, objectType, objectName, grantee)
+			tflog.Info(ctx, "Revoking object privilege", map[string]any{"sql": stmt})
+			if err := execRevoke(ctx, r.db, r.collisionRetries, stmt, r.ignoreMissingOnRevoke); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("REVOKE %s failed", priv), err.Error()+r.grantorHint(ctx, state, priv))
+			}
 		}
 	}
 }
@@ -309,10 +745,11 @@ func (r *ObjectPrivilegeResource) Delete(ctx context.Context, req resource.Delet
 func (r *ObjectPrivilegeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// ID format: GRANTEE|PRIVILEGES|OBJECT_TYPE|OBJECT_NAME
 	// Privileges are comma-separated: GRANTEE|SELECT,INSERT,UPDATE|TABLE|MYSCHEMA.MYTABLE
+	// Use "*" as privileges to import whatever is currently granted: GRANTEE|*|TABLE|MYSCHEMA.MYTABLE
 	parts := strings.Split(req.ID, "|")
 	if len(parts) != 4 {
 		resp.Diagnostics.AddError("Invalid import ID",
-			`Expected format: "GRANTEE|PRIVILEGE1,PRIVILEGE2|OBJECT_TYPE|OBJECT_NAME"`)
+			`Expected format: "GRANTEE|PRIVILEGE1,PRIVILEGE2|OBJECT_TYPE|OBJECT_NAME" or "GRANTEE|*|OBJECT_TYPE|OBJECT_NAME"`)
 		return
 	}
 
@@ -329,24 +766,129 @@ func (r *ObjectPrivilegeResource) ImportState(ctx context.Context, req resource.
 	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
 }
 
-func objectPrivilegeID(m objectPrivilegeModel) string {
+// objectPrivilegeID builds the synthetic ID. object_name is stored in state as written in the
+// configuration; the ID, resolved_object_name and Read lookups all use canonicalQualifiedIdent,
+// the form Exasol stores, so differently cased spellings of an unquoted name do not drift.
+// Privileges are normalized, deduplicated and sorted, so their order and spelling do not matter.
+func objectPrivilegeID(m objectPrivilegeModel, quoted bool) string {
 	grantee := strings.ToUpper(m.Grantee.ValueString())
 	objectType := strings.ToUpper(m.ObjectType.ValueString())
-	objectName := strings.ToUpper(m.ObjectName.ValueString())
+	objectName := canonicalQualifiedIdent(m.ObjectName.ValueString(), quoted)
 
-	// Extract and sort privileges for consistent ID
 	var privileges []string
 	m.Privileges.ElementsAs(context.Background(), &privileges, false)
-	for i, p := range privileges {
-		privileges[i] = strings.ToUpper(p)
-	}
-	sort.Strings(privileges)
-	privilegesStr := strings.Join(privileges, ",")
+	privilegesStr := strings.Join(sortedNormalized(privileges, normalizePrivilege), ",")
 
 	return fmt.Sprintf("%s|%s|%s|%s", grantee, privilegesStr, objectType, objectName)
 }
 
-func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, grantee, privilege, objectType, objectName string) (bool, error) {
+// importAllPrivileges is the privileges segment of an import ID that reads the granted privileges from the database.
+const importAllPrivileges = "*"
+
+// readObjectPrivileges returns all privileges the grantee holds on the object, sorted.
+func readObjectPrivileges(ctx context.Context, db *sql.DB, scope, grantee, objectType, storageType, objectName string) ([]string, error) {
+	match, matchArgs := objectPrivilegeMatch(objectType, storageType, objectName)
+	query := fmt.Sprintf(`SELECT DISTINCT PRIVILEGE FROM %s WHERE GRANTEE = ? AND %s ORDER BY PRIVILEGE`,
+		objPrivsView(scope), match)
+	rows, err := db.QueryContext(ctx, query, append([]any{grantee}, matchArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var privileges []string
+	for rows.Next() {
+		var privilege string
+		if err := rows.Scan(&privilege); err != nil {
+			return nil, err
+		}
+		privileges = append(privileges, privilege)
+	}
+	return privileges, rows.Err()
+}
+
+// objectPrivilegeMatch returns the WHERE fragment and arguments matching an object in the
+// EXA_*_OBJ_PRIVS views. Those views store the schema of a schema object in OBJECT_SCHEMA and
+// only the bare name in OBJECT_NAME, so a qualified "SALES.DAILY_VIEW" is split before matching.
+// Grants on views may be written with object_type TABLE (and vice versa), while the views report
+// the real type, so TABLE and VIEW match each other. A storageType of TABLE or VIEW (from
+// object_storage_type) overrides this and matches exactly the type Exasol recorded; "" or AUTO
+// keeps the auto-detection.
+func objectPrivilegeMatch(objectType, storageType, objectName string) (string, []any) {
+	typeMatch, args := "OBJECT_TYPE = ?", []any{objectType}
+	if objectType == "TABLE" || objectType == "VIEW" {
+		switch storageType {
+		case "TABLE", "VIEW":
+			args = []any{storageType}
+		default:
+			typeMatch, args = "OBJECT_TYPE IN ('TABLE', 'VIEW')", nil
+		}
+	}
+	if schemaName, name, ok := splitObjectName(objectName); ok {
+		return typeMatch + " AND OBJECT_SCHEMA = ? AND OBJECT_NAME = ?", append(args, schemaName, name)
+	}
+	return typeMatch + " AND OBJECT_NAME = ?", append(args, objectName)
+}
+
+// isSystemObjectGrantError reports whether a GRANT failed because its object is a system object,
+// as opposed to e.g. a missing grantee or a lock: Exasol rejects those with insufficient
+// privileges (SQL state 42500) or with a message naming the system object or schema.
+func isSystemObjectGrantError(err error) bool {
+	if isPermissionError(err) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "system object") || strings.Contains(msg, "system schema") ||
+		strings.Contains(msg, "system table") || strings.Contains(msg, "system view")
+}
+
+// isSystemObject reports whether objectName is, or lies in, one of Exasol's system schemas.
+// Their objects (the EXA_* dictionary views and statistics tables) cannot carry object privileges;
+// dictionary access is granted with SELECT ANY DICTIONARY.
+func isSystemObject(objectName string) bool {
+	schemaName := unquoteIdent(objectName)
+	if s, _, ok := splitObjectName(objectName); ok {
+		schemaName = s
+	}
+	switch strings.ToUpper(schemaName) {
+	case "SYS", "EXA_STATISTICS":
+		return true
+	}
+	return false
+}
+
+// splitObjectName returns the schema and bare name of a qualified object name. Only the last two
+// parts are used: the privilege views have no column for a leading catalog or database qualifier
+// (as written in some federation setups), so it is ignored rather than taken for the schema.
+// Each part is unquoted with unquoteIdent, like qualify does, so escaped quotes match the SQL.
+func splitObjectName(objectName string) (schemaName, name string, ok bool) {
+	parts := strings.Split(objectName, ".")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+	schemaName = unquoteIdent(parts[len(parts)-2])
+	name = unquoteIdent(parts[len(parts)-1])
+	return schemaName, name, true
+}
+
+// heldObjectPrivileges returns the privileges the grantee of m already holds on object, read in
+// one query. A failed lookup is logged and treated as no privileges held, so the grants are issued.
+func (r *ObjectPrivilegeResource) heldObjectPrivileges(ctx context.Context, m objectPrivilegeModel, objectType, object string) map[string]bool {
+	grantee := strings.ToUpper(m.Grantee.ValueString())
+	storageType := strings.ToUpper(m.ObjectStorageType.ValueString())
+	privileges, err := readObjectPrivileges(ctx, r.db, r.viewScope, grantee, objectType, storageType, object)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read existing object privileges; granting all", map[string]any{"object": object, "error": err.Error()})
+		return nil
+	}
+	held := make(map[string]bool, len(privileges))
+	for _, p := range privileges {
+		held[p] = true
+	}
+	return held
+}
+
+func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, scope, grantee, privilege, objectType, storageType, objectName string) (bool, error) {
 	tflog.Debug(ctx, "Checking object privilege existence", map[string]any{
 		"grantee":     grantee,
 		"privilege":   privilege,
@@ -354,14 +896,17 @@ func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, grantee, privil
 		"object_name": objectName,
 	})
 
+	match, matchArgs := objectPrivilegeMatch(objectType, storageType, objectName)
+	granteeArgs := append([]any{grantee}, matchArgs...)
+
 	// Special handling for "ALL" privilege
 	if privilege == "ALL" {
 		// First, try to find "ALL" privilege directly
-		query := `SELECT 1 FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = 'ALL' AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+		query := fmt.Sprintf(`SELECT 1 FROM %s WHERE GRANTEE = ? AND PRIVILEGE = 'ALL' AND %s`, objPrivsView(scope), match)
 		var dummy int
-		err := db.QueryRowContext(ctx, query, grantee, objectType, objectName).Scan(&dummy)
+		err := db.QueryRowContext(ctx, query, granteeArgs...).Scan(&dummy)
 		if err == nil {
-			tflog.Debug(ctx, "Object privilege 'ALL' found in EXA_DBA_OBJ_PRIVS")
+			tflog.Debug(ctx, "Object privilege 'ALL' found", map[string]any{"view": objPrivsView(scope)})
 			return true, nil
 		}
 		if err != sql.ErrNoRows {
@@ -369,9 +914,9 @@ func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, grantee, privil
 		}
 
 		// If "ALL" is not found directly, check if any individual privileges exist
-		countQuery := `SELECT COUNT(*) FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+		countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE GRANTEE = ? AND %s`, objPrivsView(scope), match)
 		var count int
-		err = db.QueryRowContext(ctx, countQuery, grantee, objectType, objectName).Scan(&count)
+		err = db.QueryRowContext(ctx, countQuery, granteeArgs...).Scan(&count)
 		if err != nil {
 			return false, err
 		}
@@ -383,9 +928,9 @@ func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, grantee, privil
 	}
 
 	// For non-ALL privileges, query directly
-	query := `SELECT 1 FROM EXA_DBA_OBJ_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = ? AND OBJECT_TYPE = ? AND OBJECT_NAME = ?`
+	query := fmt.Sprintf(`SELECT 1 FROM %s WHERE GRANTEE = ? AND PRIVILEGE = ? AND %s`, objPrivsView(scope), match)
 	var dummy int
-	err := db.QueryRowContext(ctx, query, grantee, privilege, objectType, objectName).Scan(&dummy)
+	err := db.QueryRowContext(ctx, query, append([]any{grantee, privilege}, matchArgs...)...).Scan(&dummy)
 	if err == sql.ErrNoRows {
 		return false, nil
 	}
@@ -394,3 +939,161 @@ func checkObjectPrivilegeExists(ctx context.Context, db *sql.DB, grantee, privil
 	}
 	return true, nil
 }
+
+// setExpandedPrivileges records the privileges ALL was expanded to when expand_all is set and
+// m grants ALL on a single object, and sets expanded_privileges to null otherwise. Exasol may
+// list the grant as ALL itself; the privileges known for the object type are recorded then.
+func (r *ObjectPrivilegeResource) setExpandedPrivileges(ctx context.Context, m *objectPrivilegeModel, diags *diag.Diagnostics) {
+	m.ExpandedPrivileges = types.ListNull(types.StringType)
+	if !m.ExpandAll.ValueBool() {
+		return
+	}
+	if _, ok := wildcardSchema(m.ObjectName.ValueString()); ok {
+		return
+	}
+	var privileges []string
+	diags.Append(m.Privileges.ElementsAs(ctx, &privileges, false)...)
+	if !slices.ContainsFunc(privileges, func(p string) bool { return normalizePrivilege(p) == "ALL" }) {
+		return
+	}
+
+	grantee := strings.ToUpper(m.Grantee.ValueString())
+	objectType := strings.ToUpper(m.ObjectType.ValueString())
+	storageType := strings.ToUpper(m.ObjectStorageType.ValueString())
+	objectName := canonicalQualifiedIdent(m.ObjectName.ValueString(), r.quoteIdentifiers)
+	granted, err := readObjectPrivileges(ctx, r.db, r.viewScope, grantee, objectType, storageType, objectName)
+	if err != nil {
+		diags.AddWarning("Could not expand ALL",
+			fmt.Sprintf("Reading the privileges ALL was expanded to failed, so revoking a single one will not show as drift: %s", err.Error()))
+		return
+	}
+	if slices.Contains(granted, "ALL") {
+		if known, ok := objectPrivilegesByType[objectType]; ok {
+			granted = known
+		}
+	}
+	expanded := slices.DeleteFunc(slices.Clone(granted), func(p string) bool { return p == "ALL" })
+	if len(expanded) == 0 {
+		return
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, expanded)
+	diags.Append(d...)
+	m.ExpandedPrivileges = list
+}
+
+// missingExpandedPrivileges returns the privileges of expanded the grantee no longer holds. A
+// grant still listed as ALL covers all of them.
+func missingExpandedPrivileges(expanded, granted []string) []string {
+	if slices.Contains(granted, "ALL") {
+		return nil
+	}
+	var missing []string
+	for _, p := range expanded {
+		if !slices.Contains(granted, p) {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// replaceAllPrivilege replaces ALL in privileges with the privileges of expanded that are still granted.
+func replaceAllPrivilege(privileges, expanded, granted []string) []string {
+	var out []string
+	for _, p := range privileges {
+		if normalizePrivilege(p) != "ALL" {
+			out = append(out, p)
+		}
+	}
+	for _, p := range expanded {
+		if slices.Contains(granted, p) && !slices.ContainsFunc(out, func(o string) bool { return normalizePrivilege(o) == p }) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// setObjectPrivilegeResolved fills the computed resolved_* attributes from the normalized inputs.
+func setObjectPrivilegeResolved(m *objectPrivilegeModel, quoted bool) {
+	m.ResolvedGrantee = resolvedName(m.Grantee)
+	m.ResolvedObjectType = resolvedName(m.ObjectType)
+	m.ResolvedObjectName = types.StringValue(canonicalQualifiedIdent(m.ObjectName.ValueString(), quoted))
+}
+
+// readObjectPrivilegeGrantors returns the distinct grantors of the grantee's privileges on the
+// object, limited to one privilege unless privilege is "".
+func readObjectPrivilegeGrantors(ctx context.Context, db *sql.DB, scope, grantee, objectType, storageType, objectName, privilege string) ([]string, error) {
+	match, matchArgs := objectPrivilegeMatch(objectType, storageType, objectName)
+	query := fmt.Sprintf(`SELECT DISTINCT GRANTOR FROM %s WHERE GRANTEE = ? AND %s`, objPrivsView(scope), match)
+	args := append([]any{grantee}, matchArgs...)
+	if privilege != "" {
+		query += ` AND PRIVILEGE = ?`
+		args = append(args, privilege)
+	}
+	rows, err := db.QueryContext(ctx, query+` ORDER BY GRANTOR`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grantors []string
+	for rows.Next() {
+		var grantor string
+		if err := rows.Scan(&grantor); err != nil {
+			return nil, err
+		}
+		grantors = append(grantors, grantor)
+	}
+	return grantors, rows.Err()
+}
+
+// setGrantors fills the computed grantors attribute. It is informational, so a failed lookup
+// leaves it null instead of failing the operation.
+func (r *ObjectPrivilegeResource) setGrantors(ctx context.Context, m *objectPrivilegeModel) {
+	m.Grantors = types.ListNull(types.StringType)
+	if _, ok := wildcardSchema(m.ObjectName.ValueString()); ok {
+		return
+	}
+	var grantors []string
+	err := retryRead(ctx, func() error {
+		var err error
+		grantors, err = readObjectPrivilegeGrantors(ctx, r.db, r.viewScope,
+			strings.ToUpper(m.Grantee.ValueString()), strings.ToUpper(m.ObjectType.ValueString()),
+			strings.ToUpper(m.ObjectStorageType.ValueString()),
+			canonicalQualifiedIdent(m.ObjectName.ValueString(), r.quoteIdentifiers), "")
+		return err
+	})
+	if err != nil {
+		tflog.Debug(ctx, "Could not read object privilege grantors", map[string]any{"error": err.Error()})
+		return
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, grantors)
+	if !diags.HasError() {
+		m.Grantors = list
+	}
+}
+
+// grantorHint explains a failed REVOKE when the privilege was granted by someone other than the
+// provider user: Exasol only lets the grantor, or a user with GRANT ANY OBJECT PRIVILEGE or
+// GRANT ANY PRIVILEGE, revoke it. It returns "" when the grantors cannot be determined or
+// include the provider user.
+func (r *ObjectPrivilegeResource) grantorHint(ctx context.Context, m objectPrivilegeModel, privilege string) string {
+	grantors, err := readObjectPrivilegeGrantors(ctx, r.db, r.viewScope,
+		strings.ToUpper(m.Grantee.ValueString()), strings.ToUpper(m.ObjectType.ValueString()),
+		strings.ToUpper(m.ObjectStorageType.ValueString()),
+		canonicalQualifiedIdent(m.ObjectName.ValueString(), r.quoteIdentifiers), privilege)
+	if err != nil || len(grantors) == 0 {
+		return ""
+	}
+	current, err := currentUser(ctx, r.db)
+	if err != nil {
+		return ""
+	}
+	for _, g := range grantors {
+		if strings.EqualFold(g, current) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("\n\n%s was granted by %s, not by the provider user %s. Only the grantor or a user with "+
+		"GRANT ANY OBJECT PRIVILEGE can revoke it: grant that privilege to %s or revoke it as the grantor.",
+		privilege, strings.Join(grantors, ", "), current, current)
+}
@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// wildcardSchema returns the schema of a wildcard object_name such as "SALES.*".
+// Exasol has no "all tables in schema" grant, so exasol_object_privilege expands the wildcard
+// into one grant per table (or view) at apply time. The expansion is a snapshot: objects created
+// later are only picked up by the next update of the resource.
+func wildcardSchema(objectName string) (string, bool) {
+	schemaName, ok := strings.CutSuffix(objectName, ".*")
+	if !ok || schemaName == "" || strings.Contains(schemaName, ".") {
+		return "", false
+	}
+	return schemaName, true
+}
+
+// expandWildcard lists the tables (object_type TABLE) or views (VIEW) of a schema as
+// SCHEMA.NAME in the spelling Exasol stores.
+func (r *ObjectPrivilegeResource) expandWildcard(ctx context.Context, objectType, schemaName string) ([]string, error) {
+	query := `SELECT TABLE_NAME FROM EXA_ALL_TABLES WHERE TABLE_SCHEMA = ? ORDER BY TABLE_NAME`
+	if objectType == "VIEW" {
+		query = `SELECT VIEW_NAME FROM EXA_ALL_VIEWS WHERE VIEW_SCHEMA = ? ORDER BY VIEW_NAME`
+	}
+	schemaName = canonicalIdent(unquoteIdent(schemaName), r.quoteIdentifiers)
+	rows, err := r.db.QueryContext(ctx, query, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var objects []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		objects = append(objects, schemaName+"."+name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "Expanded wildcard object name", map[string]any{"schema": schemaName, "objects": len(objects)})
+	return objects, nil
+}
+
+// expandedObjects returns the objects recorded in the expanded_objects attribute.
+func expandedObjects(ctx context.Context, m objectPrivilegeModel, diags *diag.Diagnostics) []string {
+	var objects []string
+	if !m.ExpandedObjects.IsNull() && !m.ExpandedObjects.IsUnknown() {
+		diags.Append(m.ExpandedObjects.ElementsAs(ctx, &objects, false)...)
+	}
+	return objects
+}
+
+// quoteExpandedObject quotes an object name taken from the catalog. It always uses quoted
+// identifiers: the name already has the stored spelling and may not be a regular identifier.
+func quoteExpandedObject(object string) (string, error) {
+	return qualifyIdent(object, true)
+}
+
+// wildcardPrivilegePairs returns the "PRIVILEGE|OBJECT" pairs a wildcard or plain object
+// privilege covers, keyed for diffing in Update.
+func wildcardPrivilegePairs(privileges, objects []string) map[string][2]string {
+	pairs := make(map[string][2]string, len(privileges)*len(objects))
+	for _, p := range privileges {
+		priv := normalizePrivilege(p)
+		for _, o := range objects {
+			pairs[priv+"|"+o] = [2]string{priv, o}
+		}
+	}
+	return pairs
+}
+
+// updateWildcard applies an update where the old or the new object_name is a wildcard.
+// The new object list is expanded again, so objects created since the last apply are granted,
+// and only the privilege/object pairs that differ are revoked or granted.
+func (r *ObjectPrivilegeResource) updateWildcard(ctx context.Context, plan, state *objectPrivilegeModel, diags *diag.Diagnostics) {
+	var oldPrivileges, newPrivileges []string
+	diags.Append(state.Privileges.ElementsAs(ctx, &oldPrivileges, false)...)
+	diags.Append(plan.Privileges.ElementsAs(ctx, &newPrivileges, false)...)
+	if diags.HasError() {
+		return
+	}
+	oldGrantee, err := quoteIdent(strings.ToUpper(state.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError("Invalid grantee", err.Error())
+		return
+	}
+	newGrantee, err := quoteIdent(strings.ToUpper(plan.Grantee.ValueString()), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError("Invalid grantee", err.Error())
+		return
+	}
+	oldObjectType := strings.ToUpper(state.ObjectType.ValueString())
+	newObjectType := strings.ToUpper(plan.ObjectType.ValueString())
+
+	oldObjects := []string{canonicalQualifiedIdent(state.ObjectName.ValueString(), r.quoteIdentifiers)}
+	if _, ok := wildcardSchema(state.ObjectName.ValueString()); ok {
+		oldObjects = expandedObjects(ctx, *state, diags)
+	}
+	newObjects := []string{canonicalQualifiedIdent(plan.ObjectName.ValueString(), r.quoteIdentifiers)}
+	if schemaName, ok := wildcardSchema(plan.ObjectName.ValueString()); ok {
+		if newObjects, err = r.expandWildcard(ctx, newObjectType, schemaName); err != nil {
+			diags.AddError("Expand wildcard object name failed", err.Error())
+			return
+		}
+	}
+	if diags.HasError() {
+		return
+	}
+
+	oldPairs := wildcardPrivilegePairs(oldPrivileges, oldObjects)
+	newPairs := wildcardPrivilegePairs(newPrivileges, newObjects)
+	sameTarget := oldGrantee == newGrantee && oldObjectType == newObjectType
+
+	for key, pair := range oldPairs {
+		if _, keep := newPairs[key]; keep && sameTarget {
+			continue
+		}
+		object, err := quoteExpandedObject(pair[1])
+		if err != nil {
+			diags.AddError("Invalid object name", err.Error())
+			return
+		}
+		stmt := fmt.Sprintf(`REVOKE %s ON %s %s FROM %s`, pair[0], oldObjectType, object, oldGrantee)
+		tflog.Info(ctx, "Revoking object privilege", map[string]any{"sql": stmt})
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+			tflog.Warn(ctx, "REVOKE failed (privilege or object may not exist)", map[string]any{"error": err.Error()})
+		}
+	}
+	for key, pair := range newPairs {
+		if _, had := oldPairs[key]; had && sameTarget {
+			continue
+		}
+		object, err := quoteExpandedObject(pair[1])
+		if err != nil {
+			diags.AddError("Invalid object name", err.Error())
+			return
+		}
+		stmt := fmt.Sprintf(`GRANT %s ON %s %s TO %s`, pair[0], newObjectType, object, newGrantee)
+		tflog.Info(ctx, "Granting object privilege", map[string]any{"sql": stmt})
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+			diags.AddError(fmt.Sprintf("GRANT %s failed", pair[0]), err.Error())
+			return
+		}
+	}
+
+	plan.ExpandedObjects = types.ListNull(types.StringType)
+	if _, ok := wildcardSchema(plan.ObjectName.ValueString()); ok {
+		list, d := types.ListValueFrom(ctx, types.StringType, newObjects)
+		diags.Append(d...)
+		plan.ExpandedObjects = list
+	}
+}
@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ObjectSizeDataSource{}
+var _ datasource.DataSourceWithConfigure = &ObjectSizeDataSource{}
+
+// ObjectSizeDataSource reads the size of a schema or of an object in a schema from EXA_ALL_OBJECT_SIZES.
+type ObjectSizeDataSource struct {
+	db               *sql.DB
+	quoteIdentifiers bool
+	readTimeout      time.Duration
+}
+
+func NewObjectSizeDataSource() datasource.DataSource {
+	return &ObjectSizeDataSource{}
+}
+
+func (d *ObjectSizeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_size"
+}
+
+func (d *ObjectSizeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads raw and compressed sizes of a schema, or of a table or other object in it, from EXA_ALL_OBJECT_SIZES. " +
+			"A missing object is not an error: exists is false and the sizes are null.",
+		Attributes: map[string]schema.Attribute{
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "Schema name.",
+			},
+			"object_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object in the schema. If omitted, the sizes of the schema itself are returned.",
+			},
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the object was found.",
+			},
+			"object_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object type as reported by Exasol (SCHEMA, TABLE, ...).",
+			},
+			"raw_object_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Uncompressed size in bytes.",
+			},
+			"mem_object_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Compressed size in bytes.",
+			},
+			"raw_object_size_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Raw size limit (quota) in bytes, or null if none is set.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SCHEMA or SCHEMA.OBJECT_NAME.",
+			},
+		},
+	}
+}
+
+func (d *ObjectSizeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.quoteIdentifiers = c.QuoteIdentifiers
+		d.readTimeout = c.ReadTimeout
+	}
+}
+
+type objectSizeModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Schema             types.String `tfsdk:"schema"`
+	ObjectName         types.String `tfsdk:"object_name"`
+	Exists             types.Bool   `tfsdk:"exists"`
+	ObjectType         types.String `tfsdk:"object_type"`
+	RawObjectSize      types.Int64  `tfsdk:"raw_object_size"`
+	MemObjectSize      types.Int64  `tfsdk:"mem_object_size"`
+	RawObjectSizeLimit types.Int64  `tfsdk:"raw_object_size_limit"`
+}
+
+func (d *ObjectSizeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg objectSizeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaName := canonicalIdent(cfg.Schema.ValueString(), d.quoteIdentifiers)
+	query, args := buildObjectSizeQuery(schemaName, "")
+	cfg.ID = types.StringValue(schemaName)
+	if !cfg.ObjectName.IsNull() && cfg.ObjectName.ValueString() != "" {
+		objectName := canonicalIdent(cfg.ObjectName.ValueString(), d.quoteIdentifiers)
+		query, args = buildObjectSizeQuery(schemaName, objectName)
+		cfg.ID = types.StringValue(schemaName + "." + objectName)
+	}
+
+	var objectType string
+	var raw, mem, limit sql.NullFloat64
+	err := retryRead(ctx, func() error {
+		return d.db.QueryRowContext(ctx, query, args...).Scan(&objectType, &raw, &mem, &limit)
+	})
+	if err == sql.ErrNoRows {
+		tflog.Debug(ctx, "Object not found in EXA_ALL_OBJECT_SIZES", map[string]any{"id": cfg.ID.ValueString()})
+		cfg.Exists = types.BoolValue(false)
+		cfg.ObjectType = types.StringNull()
+		cfg.RawObjectSize = types.Int64Null()
+		cfg.MemObjectSize = types.Int64Null()
+		cfg.RawObjectSizeLimit = types.Int64Null()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read object size failed", err.Error())
+		return
+	}
+
+	cfg.Exists = types.BoolValue(true)
+	cfg.ObjectType = types.StringValue(objectType)
+	cfg.RawObjectSize = nullableInt64(raw)
+	cfg.MemObjectSize = nullableInt64(mem)
+	cfg.RawObjectSizeLimit = nullableInt64(limit)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// buildObjectSizeQuery selects the size row of a schema (objectName empty) or of an object in it.
+// Objects are keyed by ROOT_NAME, which holds the owning schema.
+func buildObjectSizeQuery(schemaName, objectName string) (string, []any) {
+	const columns = `SELECT OBJECT_TYPE, RAW_OBJECT_SIZE, MEM_OBJECT_SIZE, RAW_OBJECT_SIZE_LIMIT FROM EXA_ALL_OBJECT_SIZES`
+	if objectName == "" {
+		return columns + ` WHERE OBJECT_NAME = ? AND OBJECT_TYPE = 'SCHEMA'`, []any{schemaName}
+	}
+	return columns + ` WHERE ROOT_NAME = ? AND OBJECT_NAME = ? AND OBJECT_TYPE <> 'SCHEMA'`, []any{schemaName, objectName}
+}
+
+// nullableInt64 converts a nullable DECIMAL column into a Terraform int64.
+func nullableInt64(v sql.NullFloat64) types.Int64 {
+	if !v.Valid {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v.Float64))
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// supportedObjectTypes are the object types privileges can be granted on.
+// Add new server object types here; both object privilege resources pick them up.
+var supportedObjectTypes = []string{"SCHEMA", "TABLE", "VIEW", "SCRIPT", "FUNCTION", "CONNECTION"}
+
+var _ validator.String = objectTypeValidator{}
+
+// objectTypeValidator restricts object_type to a fixed set, compared case-insensitively.
+// hints adds a resource-specific explanation for values that are rejected on purpose.
+type objectTypeValidator struct {
+	allowed []string
+	hints   map[string]string
+}
+
+func (v objectTypeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s (case-insensitive)", strings.Join(v.allowed, ", "))
+}
+
+func (v objectTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v objectTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	objectType := strings.ToUpper(strings.TrimSpace(req.ConfigValue.ValueString()))
+	if slices.Contains(v.allowed, objectType) {
+		return
+	}
+	detail := fmt.Sprintf("Object type %q is not supported; %s.", req.ConfigValue.ValueString(), v.Description(ctx))
+	if hint, ok := v.hints[objectType]; ok {
+		detail += " " + hint
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Unsupported object_type", detail)
+}
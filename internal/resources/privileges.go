@@ -0,0 +1,229 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Known system privileges and object types per Exasol major version. The sets are only used to
+// warn about likely typos; unknown names are still sent to the server, which has the final say.
+
+var systemPrivilegesV7 = []string{
+	"ACCESS ANY CONNECTION",
+	"ALL PRIVILEGES",
+	"ALTER ANY CONNECTION",
+	"ALTER ANY SCHEMA",
+	"ALTER ANY TABLE",
+	"ALTER ANY VIRTUAL SCHEMA",
+	"ALTER ANY VIRTUAL SCHEMA REFRESH",
+	"ALTER SYSTEM",
+	"ALTER USER",
+	"CREATE ANY FUNCTION",
+	"CREATE ANY SCRIPT",
+	"CREATE ANY TABLE",
+	"CREATE ANY VIEW",
+	"CREATE CONNECTION",
+	"CREATE FUNCTION",
+	"CREATE ROLE",
+	"CREATE SCHEMA",
+	"CREATE SCRIPT",
+	"CREATE SESSION",
+	"CREATE TABLE",
+	"CREATE USER",
+	"CREATE VIEW",
+	"CREATE VIRTUAL SCHEMA",
+	"DELETE ANY TABLE",
+	"DROP ANY CONNECTION",
+	"DROP ANY FUNCTION",
+	"DROP ANY ROLE",
+	"DROP ANY SCHEMA",
+	"DROP ANY SCRIPT",
+	"DROP ANY TABLE",
+	"DROP ANY VIEW",
+	"DROP ANY VIRTUAL SCHEMA",
+	"DROP USER",
+	"EXECUTE ANY FUNCTION",
+	"EXECUTE ANY SCRIPT",
+	"EXPORT",
+	"GRANT ANY CONNECTION",
+	"GRANT ANY OBJECT PRIVILEGE",
+	"GRANT ANY PRIORITY",
+	"GRANT ANY PRIVILEGE",
+	"GRANT ANY ROLE",
+	"IMPERSONATE ANY USER",
+	"IMPORT",
+	"INSERT ANY TABLE",
+	"KILL ANY SESSION",
+	"MANAGE CONSUMER GROUPS",
+	"SELECT ANY DICTIONARY",
+	"SELECT ANY TABLE",
+	"UPDATE ANY TABLE",
+	"USE ANY CONNECTION",
+	"USE ANY SCHEMA",
+}
+
+var objectTypesV7 = []string{"SCHEMA", "TABLE", "VIEW", "FUNCTION", "SCRIPT", "CONNECTION"}
+
+// knownPrivilegeSets maps an Exasol major version to its system privileges and object types.
+// Exasol 8 reuses the 7.x lists until its privilege names are confirmed (see TODO.md).
+var knownPrivilegeSets = map[int]struct {
+	systemPrivileges []string
+	objectTypes      []string
+}{
+	7: {systemPrivilegesV7, objectTypesV7},
+	8: {systemPrivilegesV7, objectTypesV7},
+}
+
+// privilegeSetVersion picks the closest known version at or below major, or 0 if none applies.
+func privilegeSetVersion(major int) int {
+	best := 0
+	for v := range knownPrivilegeSets {
+		if v <= major && v > best {
+			best = v
+		}
+	}
+	return best
+}
+
+// isKnownSystemPrivilege reports whether privilege exists on the given server version.
+// An unknown version (0 or older than any known set) accepts everything.
+func isKnownSystemPrivilege(major int, privilege string) bool {
+	set, ok := knownPrivilegeSets[privilegeSetVersion(major)]
+	if !ok {
+		return true
+	}
+	return slices.Contains(set.systemPrivileges, privilege)
+}
+
+// isKnownObjectType reports whether objectType accepts object privileges on the given server version.
+func isKnownObjectType(major int, objectType string) bool {
+	set, ok := knownPrivilegeSets[privilegeSetVersion(major)]
+	if !ok {
+		return true
+	}
+	return slices.Contains(set.objectTypes, objectType)
+}
+
+// warnUnknownSystemPrivilege adds a warning when privilege is not known for the server version.
+func warnUnknownSystemPrivilege(diags *diag.Diagnostics, major int, privilege string) {
+	if !isKnownSystemPrivilege(major, privilege) {
+		diags.AddWarning("Unknown system privilege",
+			fmt.Sprintf("%q is not a known system privilege on Exasol %d; the server may reject it.", privilege, major))
+	}
+}
+
+// warnUnknownObjectType adds a warning when objectType is not known for the server version.
+func warnUnknownObjectType(diags *diag.Diagnostics, major int, objectType string) {
+	if !isKnownObjectType(major, objectType) {
+		diags.AddWarning("Unknown object type",
+			fmt.Sprintf("%q is not a known object type on Exasol %d; the server may reject it.", objectType, major))
+	}
+}
+
+// privilegeNamePattern matches single and multi-word privilege names such as SELECT or CREATE TABLE.
+var privilegeNamePattern = regexp.MustCompile(`^[A-Z]+( [A-Z]+)*$`)
+
+// normalizePrivilege uppercases a privilege and collapses runs of whitespace, so "create  table"
+// matches the "CREATE TABLE" stored in EXA_DBA_OBJ_PRIVS.PRIVILEGE.
+func normalizePrivilege(privilege string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(privilege)), " ")
+}
+
+// normalizedDuplicates returns the values that normalize to the same form as an earlier value,
+// e.g. "select" after "SELECT". The grant resources build their IDs from normalized values, so
+// such entries are the same grant written twice.
+func normalizedDuplicates(values []string, normalize func(string) string) []string {
+	seen := make(map[string]bool, len(values))
+	var dups []string
+	for _, v := range values {
+		n := normalize(v)
+		if seen[n] {
+			dups = append(dups, v)
+		}
+		seen[n] = true
+	}
+	return dups
+}
+
+// sortedNormalized normalizes values, drops duplicates and sorts them, the deterministic form
+// used in synthetic grant IDs.
+func sortedNormalized(values []string, normalize func(string) string) []string {
+	set := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if n := normalize(v); !set[n] {
+			set[n] = true
+			out = append(out, n)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// acknowledgeBroadPrivilegeAttribute is the acknowledge_broad_privilege attribute of the grant resources.
+func acknowledgeBroadPrivilegeAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Description: "Suppress the plan-time warning for broad privileges (ALL, or any privilege containing ANY " +
+			"such as CREATE ANY TABLE) once the grant has been reviewed.",
+	}
+}
+
+// isBroadPrivilege reports whether a normalized privilege is ALL [PRIVILEGES] or an ANY privilege
+// such as CREATE ANY TABLE, which apply to every object of a kind.
+func isBroadPrivilege(privilege string) bool {
+	if privilege == "ALL" || privilege == "ALL PRIVILEGES" {
+		return true
+	}
+	for _, word := range strings.Fields(privilege) {
+		if word == "ANY" {
+			return true
+		}
+	}
+	return false
+}
+
+// warnBroadPrivilege adds a warning for a broad privilege unless acknowledge_broad_privilege is true.
+// Unknown values are skipped; the check runs again once they are known.
+func warnBroadPrivilege(diags *diag.Diagnostics, p path.Path, privilege types.String, acknowledged types.Bool) {
+	if privilege.IsNull() || privilege.IsUnknown() || acknowledged.ValueBool() {
+		return
+	}
+	normalized := normalizePrivilege(privilege.ValueString())
+	if !isBroadPrivilege(normalized) {
+		return
+	}
+	diags.AddAttributeWarning(p, "Broad privilege",
+		fmt.Sprintf("%s grants access to every object it covers, not a single one. Double-check that this is intended; "+
+			"set acknowledge_broad_privilege = true to silence this warning.", normalized))
+}
+
+// objectPrivilegesByType lists the object privileges Exasol accepts per object type. Schemas are
+// missing on purpose: privileges granted on a schema apply to its objects, so almost any object
+// privilege is valid there. ALL is valid for every type.
+var objectPrivilegesByType = map[string][]string{
+	"TABLE":      {"ALTER", "SELECT", "INSERT", "UPDATE", "DELETE", "REFERENCES"},
+	"VIEW":       {"SELECT"},
+	"FUNCTION":   {"EXECUTE"},
+	"SCRIPT":     {"EXECUTE"},
+	"CONNECTION": {"ACCESS"},
+}
+
+// isObjectPrivilegeFor reports whether a normalized privilege can be granted on objectType.
+func isObjectPrivilegeFor(objectType, privilege string) bool {
+	allowed, ok := objectPrivilegesByType[objectType]
+	return !ok || privilege == "ALL" || privilege == "ALL PRIVILEGES" || slices.Contains(allowed, privilege)
+}
+
+// isValidPrivilegeName reports whether a normalized privilege is safe to splice into GRANT/REVOKE.
+func isValidPrivilegeName(privilege string) bool {
+	return privilegeNamePattern.MatchString(privilege)
+}
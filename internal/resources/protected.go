@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// protectedAttribute is the protected attribute shared by the grant resources. Unlike the
+// prevent_destroy lifecycle argument it is stored in the state, so it still guards the grant when
+// the resource block is removed from the configuration or the whole configuration is destroyed.
+func protectedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Description: "Refuse to revoke the grant on destroy or replacement. To remove a protected grant, " +
+			"set protected = false and apply first.",
+	}
+}
+
+// refuseProtectedDelete reports an error and returns true when the state is protected.
+func refuseProtectedDelete(protected types.Bool, what string, diags *diag.Diagnostics) bool {
+	if !protected.ValueBool() {
+		return false
+	}
+	diags.AddError("Grant is protected",
+		fmt.Sprintf("%s has protected = true and is not revoked. Set protected = false and apply, then destroy it.", what))
+	return true
+}
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	exaerrors "github.com/exasol/exasol-driver-go/pkg/errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readRetries is how often a Read query is repeated after a transient failure.
+const readRetries = 3
+
+// readRetryDelay is the wait before the first Read retry; it doubles after each attempt.
+const readRetryDelay = 500 * time.Millisecond
+
+// isTransientError reports whether err is a connection-level failure or a transaction
+// collision (SQL error 40001) that may succeed on retry. sql.ErrNoRows and other server
+// errors are logical failures and are never retried.
+func isTransientError(err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, exaerrors.ErrInvalidConn) ||
+		errors.Is(err, exaerrors.ErrClosed) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return strings.Contains(err.Error(), "40001")
+}
+
+// Read permission policies select how Read reacts when the provider user has lost access to a
+// system view (provider attribute on_read_permission_error).
+const (
+	ReadPermissionErrorFail     = "fail"
+	ReadPermissionErrorWarnKeep = "warn_keep"
+)
+
+// isPermissionError reports whether err is Exasol's insufficient privileges error (SQL state 42500),
+// e.g. a system view query after SELECT ANY DICTIONARY was revoked from the provider user.
+func isPermissionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "42500") || strings.Contains(strings.ToLower(msg), "insufficient privileges")
+}
+
+// readFailed reports a failed Read query. Under the warn_keep policy a permission error becomes a
+// warning and Read returns without touching resp.State, which still holds the prior state.
+func readFailed(ctx context.Context, policy string, diags *diag.Diagnostics, summary string, err error) {
+	if policy == ReadPermissionErrorWarnKeep && isPermissionError(err) {
+		tflog.Warn(ctx, "Permission denied during Read, keeping existing state", map[string]any{"error": err.Error()})
+		diags.AddWarning(summary+", keeping existing state",
+			"The provider user may no longer read the system views this resource is reconciled from. "+
+				"State is left unchanged because on_read_permission_error is warn_keep; drift is not detected "+
+				"until access is restored.\n\n"+err.Error())
+		return
+	}
+	diags.AddError(summary, err.Error())
+}
+
+// retryRead runs a Read query, retrying transient failures with exponential backoff.
+// The last error is returned unchanged so callers can still test for sql.ErrNoRows.
+// Callers must not remove a resource from state on a transient error.
+func retryRead(ctx context.Context, query func() error) error {
+	delay := readRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := query()
+		if !isTransientError(err) || attempt == readRetries {
+			return err
+		}
+		tflog.Warn(ctx, "Transient error during Read, retrying", map[string]any{
+			"attempt":    attempt + 1,
+			"maxRetries": readRetries,
+			"waitMs":     delay.Milliseconds(),
+			"error":      err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
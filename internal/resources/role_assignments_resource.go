@@ -0,0 +1,577 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RoleAssignmentsResource{}
+var _ resource.ResourceWithImportState = &RoleAssignmentsResource{}
+var _ resource.ResourceWithValidateConfig = &RoleAssignmentsResource{}
+
+// RoleAssignmentsResource grants every role in a set to every grantee in a set.
+// It is meant for RBAC bootstrap modules where one resource per pair gets unwieldy.
+type RoleAssignmentsResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	viewScope            string
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+func NewRoleAssignmentsResource() resource.Resource {
+	return &RoleAssignmentsResource{}
+}
+
+func (r *RoleAssignmentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignments"
+}
+
+func (r *RoleAssignmentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants each role in 'roles' to each user or role in 'grantees' (the cross-product). " +
+			"Updates only grant or revoke the pairs that changed.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Roles to grant.",
+			},
+			"grantees": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Users or roles receiving every role in 'roles'.",
+			},
+			"with_admin_option": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Default ADMIN OPTION for every pair.",
+			},
+			"admin_option": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-pair ADMIN OPTION overrides keyed by \"ROLE|GRANTEE\" (matched case-insensitively).",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform ID in format: ROLE1,ROLE2|GRANTEE1,GRANTEE2",
+			},
+		},
+	}
+}
+
+func (r *RoleAssignmentsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type roleAssignmentsModel struct {
+	ID              types.String `tfsdk:"id"`
+	Roles           types.Set    `tfsdk:"roles"`
+	Grantees        types.Set    `tfsdk:"grantees"`
+	WithAdminOption types.Bool   `tfsdk:"with_admin_option"`
+	AdminOption     types.Map    `tfsdk:"admin_option"`
+	Protected       types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL  types.String `tfsdk:"last_applied_sql"`
+}
+
+// roleAssignmentKey identifies one role/grantee pair of the matrix (both uppercase).
+type roleAssignmentKey struct {
+	Role    string
+	Grantee string
+}
+
+func (k roleAssignmentKey) String() string {
+	return k.Role + "|" + k.Grantee
+}
+
+func (r *RoleAssignmentsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg roleAssignmentsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []attr.Value{cfg.Roles, cfg.Grantees, cfg.WithAdminOption, cfg.AdminOption} {
+		if v.IsUnknown() {
+			return
+		}
+	}
+	for _, e := range append(cfg.Roles.Elements(), cfg.Grantees.Elements()...) {
+		if e.IsUnknown() {
+			return
+		}
+	}
+
+	for _, list := range []struct {
+		attr string
+		set  types.Set
+	}{{"roles", cfg.Roles}, {"grantees", cfg.Grantees}} {
+		var names []string
+		resp.Diagnostics.Append(list.set.ElementsAs(ctx, &names, false)...)
+		for _, dup := range normalizedDuplicates(names, upper) {
+			resp.Diagnostics.AddAttributeWarning(path.Root(list.attr), "Duplicate "+strings.TrimSuffix(list.attr, "s"),
+				fmt.Sprintf("%q appears more than once in %s when compared case-insensitively.", dup, list.attr))
+		}
+	}
+
+	matrix, diags := roleAssignmentMatrix(ctx, cfg)
+	if diags.HasError() {
+		return
+	}
+	for _, key := range sortedRoleAssignmentKeys(matrix) {
+		if matrix[key] && isPublicGrantee(key.Grantee) {
+			resp.Diagnostics.AddAttributeError(path.Root("grantees"), "PUBLIC cannot receive ADMIN OPTION",
+				fmt.Sprintf("Role %s would be granted to PUBLIC WITH ADMIN OPTION. %s", key.Role, publicAdminOptionDetail))
+		}
+	}
+}
+
+func (r *RoleAssignmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_assignments")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan roleAssignmentsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	matrix, diags := roleAssignmentMatrix(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Stop at the first failure without saving state and revoke the pairs granted so far, so the
+	// next apply starts over instead of finding orphaned grants. The rollback runs on its own
+	// context, as ctx may just have hit query_timeout.
+	var granted []roleAssignmentKey
+	for _, key := range sortedRoleAssignmentKeys(matrix) {
+		if !r.grantPair(ctx, key, matrix[key], &resp.Diagnostics) {
+			rctx, cancel := withTimeout(context.WithoutCancel(ctx), r.deleteTimeout)
+			defer cancel()
+			for _, g := range granted {
+				r.revokePair(rctx, g, &resp.Diagnostics)
+			}
+			return
+		}
+		granted = append(granted, key)
+	}
+
+	plan.ID = types.StringValue(roleAssignmentsID(ctx, plan))
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleAssignmentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var state roleAssignmentsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles, grantees []string
+	resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &roles, false)...)
+	resp.Diagnostics.Append(state.Grantees.ElementsAs(ctx, &grantees, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	expected, diags := roleAssignmentMatrix(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var actual map[roleAssignmentKey]bool
+	err := retryRead(ctx, func() error {
+		var err error
+		actual, err = readRoleAssignments(ctx, r.db, r.viewScope, roles)
+		return err
+	})
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read role assignments failed", err)
+		return
+	}
+
+	// Drop roles that are no longer granted to anyone in the matrix (e.g. the role was dropped),
+	// then drop grantees that are missing at least one of the remaining roles. Terraform then
+	// plans to add them back, which re-grants the missing pairs.
+	var keptRoles []string
+	for _, role := range roles {
+		for _, grantee := range grantees {
+			if _, ok := actual[roleAssignmentKey{Role: upper(role), Grantee: upper(grantee)}]; ok {
+				keptRoles = append(keptRoles, role)
+				break
+			}
+		}
+	}
+	var keptGrantees []string
+	for _, grantee := range grantees {
+		complete := true
+		for _, role := range keptRoles {
+			if _, ok := actual[roleAssignmentKey{Role: upper(role), Grantee: upper(grantee)}]; !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			keptGrantees = append(keptGrantees, grantee)
+		}
+	}
+	if len(keptRoles) == 0 || len(keptGrantees) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Record admin option drift as overrides so the plan shows it
+	overrides := map[string]bool{}
+	if !state.AdminOption.IsNull() {
+		resp.Diagnostics.Append(state.AdminOption.ElementsAs(ctx, &overrides, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	kept := map[string]bool{}
+	for _, role := range keptRoles {
+		for _, grantee := range keptGrantees {
+			kept[roleAssignmentKey{Role: upper(role), Grantee: upper(grantee)}.String()] = true
+		}
+	}
+	for k := range overrides {
+		// Overrides for pruned pairs would no longer match the matrix
+		if !kept[upper(k)] {
+			delete(overrides, k)
+		}
+	}
+	for _, role := range keptRoles {
+		for _, grantee := range keptGrantees {
+			key := roleAssignmentKey{Role: upper(role), Grantee: upper(grantee)}
+			if actual[key] == expected[key] {
+				continue
+			}
+			mapKey := key.String()
+			for k := range overrides {
+				if strings.EqualFold(k, mapKey) {
+					mapKey = k
+					break
+				}
+			}
+			overrides[mapKey] = actual[key]
+		}
+	}
+
+	rolesSet, diags := types.SetValueFrom(ctx, types.StringType, keptRoles)
+	resp.Diagnostics.Append(diags...)
+	granteesSet, diags := types.SetValueFrom(ctx, types.StringType, keptGrantees)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Roles = rolesSet
+	state.Grantees = granteesSet
+	if len(overrides) > 0 || !state.AdminOption.IsNull() {
+		overridesMap, diags := types.MapValueFrom(ctx, types.BoolType, overrides)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AdminOption = overridesMap
+	}
+	state.ID = types.StringValue(roleAssignmentsID(ctx, state))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RoleAssignmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_assignments")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, state roleAssignmentsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	oldMatrix, diags := roleAssignmentMatrix(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	newMatrix, diags := roleAssignmentMatrix(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toRevoke, toGrant := diffRoleAssignments(oldMatrix, newMatrix)
+	for _, key := range toRevoke {
+		if !r.revokePair(ctx, key, &resp.Diagnostics) {
+			return
+		}
+	}
+	for _, key := range toGrant {
+		if !r.grantPair(ctx, key, newMatrix[key], &resp.Diagnostics) {
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(roleAssignmentsID(ctx, plan))
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleAssignmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_assignments")
+
+	var state roleAssignmentsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if refuseProtectedDelete(state.Protected, "exasol_role_assignments "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	matrix, diags := roleAssignmentMatrix(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, key := range sortedRoleAssignmentKeys(matrix) {
+		r.revokePair(ctx, key, &resp.Diagnostics)
+	}
+}
+
+func (r *RoleAssignmentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: ROLE1,ROLE2|GRANTEE1,GRANTEE2
+	parts := strings.Split(req.ID, "|")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			`Expected format: "ROLE1,ROLE2|GRANTEE1,GRANTEE2"`)
+		return
+	}
+
+	var roles, grantees []string
+	for _, role := range strings.Split(parts[0], ",") {
+		roles = append(roles, strings.TrimSpace(role))
+	}
+	for _, grantee := range strings.Split(parts[1], ",") {
+		grantees = append(grantees, strings.TrimSpace(grantee))
+	}
+
+	rolesSet, diags := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(diags...)
+	granteesSet, diags := types.SetValueFrom(ctx, types.StringType, grantees)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("roles"), rolesSet)
+	resp.State.SetAttribute(ctx, path.Root("grantees"), granteesSet)
+	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
+}
+
+func (r *RoleAssignmentsResource) grantPair(ctx context.Context, key roleAssignmentKey, withAdmin bool, diags *diag.Diagnostics) bool {
+	stmt, err := buildRoleGrantSQL(key.Role, key.Grantee, r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError("Invalid role assignment", err.Error())
+		return false
+	}
+	if withAdmin {
+		stmt += " WITH ADMIN OPTION"
+	}
+	tflog.Info(ctx, "Granting role", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		diags.AddError(fmt.Sprintf("GRANT %s failed", key), err.Error())
+		return false
+	}
+	return true
+}
+
+func (r *RoleAssignmentsResource) revokePair(ctx context.Context, key roleAssignmentKey, diags *diag.Diagnostics) bool {
+	stmt, err := buildRoleRevokeSQL(key.Role, key.Grantee, r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError("Invalid role assignment", err.Error())
+		return false
+	}
+	tflog.Info(ctx, "Revoking role", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		diags.AddError(fmt.Sprintf("REVOKE %s failed", key), err.Error())
+		return false
+	}
+	return true
+}
+
+// roleAssignmentMatrix expands the model into role/grantee pairs and their admin option.
+func roleAssignmentMatrix(ctx context.Context, m roleAssignmentsModel) (map[roleAssignmentKey]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var roles, grantees []string
+	diags.Append(m.Roles.ElementsAs(ctx, &roles, false)...)
+	diags.Append(m.Grantees.ElementsAs(ctx, &grantees, false)...)
+
+	overrides := map[string]bool{}
+	if !m.AdminOption.IsNull() && !m.AdminOption.IsUnknown() {
+		diags.Append(m.AdminOption.ElementsAs(ctx, &overrides, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	defaultAdmin := !m.WithAdminOption.IsNull() && m.WithAdminOption.ValueBool()
+	matrix := make(map[roleAssignmentKey]bool, len(roles)*len(grantees))
+	for _, role := range roles {
+		for _, grantee := range grantees {
+			matrix[roleAssignmentKey{Role: upper(role), Grantee: upper(grantee)}] = defaultAdmin
+		}
+	}
+	for k, withAdmin := range overrides {
+		parts := strings.Split(k, "|")
+		key := roleAssignmentKey{Role: upper(parts[0])}
+		if len(parts) == 2 {
+			key.Grantee = upper(parts[1])
+		}
+		if _, ok := matrix[key]; !ok {
+			diags.AddError("Invalid admin_option key",
+				fmt.Sprintf("Key %q does not match a ROLE|GRANTEE pair of this resource.", k))
+			continue
+		}
+		matrix[key] = withAdmin
+	}
+	return matrix, diags
+}
+
+// diffRoleAssignments returns the pairs to revoke and to grant to move from old to new.
+// A pair whose admin option changed is revoked and granted again.
+func diffRoleAssignments(oldMatrix, newMatrix map[roleAssignmentKey]bool) (toRevoke, toGrant []roleAssignmentKey) {
+	for _, key := range sortedRoleAssignmentKeys(oldMatrix) {
+		if withAdmin, ok := newMatrix[key]; !ok || withAdmin != oldMatrix[key] {
+			toRevoke = append(toRevoke, key)
+		}
+	}
+	for _, key := range sortedRoleAssignmentKeys(newMatrix) {
+		if withAdmin, ok := oldMatrix[key]; !ok || withAdmin != newMatrix[key] {
+			toGrant = append(toGrant, key)
+		}
+	}
+	return toRevoke, toGrant
+}
+
+func sortedRoleAssignmentKeys(matrix map[roleAssignmentKey]bool) []roleAssignmentKey {
+	keys := make([]roleAssignmentKey, 0, len(matrix))
+	for k := range matrix {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	return keys
+}
+
+// readRoleAssignments loads all grants of the given roles with a single query.
+func readRoleAssignments(ctx context.Context, db *sql.DB, scope string, roles []string) (map[roleAssignmentKey]bool, error) {
+	if len(roles) == 0 {
+		return map[roleAssignmentKey]bool{}, nil
+	}
+	placeholders := make([]string, len(roles))
+	args := make([]any, len(roles))
+	for i, role := range roles {
+		placeholders[i] = "?"
+		args[i] = upper(role)
+	}
+	query := fmt.Sprintf(`SELECT GRANTED_ROLE, GRANTEE, ADMIN_OPTION FROM %s WHERE GRANTED_ROLE IN (%s)`,
+		rolePrivsView(scope), strings.Join(placeholders, ", "))
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actual := map[roleAssignmentKey]bool{}
+	for rows.Next() {
+		var role, grantee string
+		var adminOption any
+		if err := rows.Scan(&role, &grantee, &adminOption); err != nil {
+			return nil, err
+		}
+		actual[roleAssignmentKey{Role: role, Grantee: grantee}] = parseAdminOption(adminOption)
+	}
+	return actual, rows.Err()
+}
+
+// roleAssignmentsID builds the synthetic ID from the uppercased, deduplicated and sorted roles
+// and grantees.
+func roleAssignmentsID(ctx context.Context, m roleAssignmentsModel) string {
+	var roles, grantees []string
+	m.Roles.ElementsAs(ctx, &roles, false)
+	m.Grantees.ElementsAs(ctx, &grantees, false)
+	return strings.Join(sortedNormalized(roles, upper), ",") + "|" + strings.Join(sortedNormalized(grantees, upper), ",")
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
@@ -17,10 +18,20 @@ import (
 
 var _ resource.Resource = &RoleGrantResource{}
 var _ resource.ResourceWithImportState = &RoleGrantResource{}
+var _ resource.ResourceWithValidateConfig = &RoleGrantResource{}
 
 // RoleGrantResource manages granting roles to users or other roles.
 type RoleGrantResource struct {
-	db *sql.DB
+	db                    *sql.DB
+	quoteIdentifiers      bool
+	viewScope             string
+	queryTimeout          time.Duration
+	readTimeout           time.Duration
+	deleteTimeout         time.Duration
+	collisionRetries      int
+	ignoreMissingOnRevoke bool
+	readPermissionPolicy  string
+	operations            *exasolclient.OperationLimiter
 }
 
 func NewRoleGrantResource() resource.Resource {
@@ -48,6 +59,16 @@ func (r *RoleGrantResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				Description: "Grant the role with ADMIN OPTION, allowing the grantee to grant this role to others.",
 			},
+			"resolved_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role name as stored in Exasol.",
+			},
+			"resolved_grantee": schema.StringAttribute{
+				Computed:    true,
+				Description: "Grantee name as stored in Exasol.",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Terraform ID in format: ROLE|GRANTEE|ADMIN_OPTION",
@@ -62,6 +83,15 @@ func (r *RoleGrantResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.ignoreMissingOnRevoke = c.IgnoreMissingOnRevoke
+		r.operations = c.Operations
 	}
 }
 
@@ -70,9 +100,32 @@ type roleGrantModel struct {
 	Role            types.String `tfsdk:"role"`
 	Grantee         types.String `tfsdk:"grantee"`
 	WithAdminOption types.Bool   `tfsdk:"with_admin_option"`
+	ResolvedRole    types.String `tfsdk:"resolved_role"`
+	ResolvedGrantee types.String `tfsdk:"resolved_grantee"`
+	Protected       types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL  types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *RoleGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg roleGrantModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rejectPublicAdminOption(&resp.Diagnostics, path.Root("grantee"), cfg.Grantee, cfg.WithAdminOption)
 }
 
 func (r *RoleGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan roleGrantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -97,22 +150,31 @@ func (r *RoleGrantResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Build GRANT statement
-	stmt := fmt.Sprintf(`GRANT "%s" TO "%s"`, role, grantee)
+	stmt, err := buildRoleGrantSQL(role, grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid role grant", err.Error())
+		return
+	}
 	if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 		stmt += " WITH ADMIN OPTION"
 	}
 
 	tflog.Info(ctx, "Granting role", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
 		resp.Diagnostics.AddError("GRANT failed", err.Error())
 		return
 	}
 
 	plan.ID = types.StringValue(roleGrantID(plan))
+	setRoleGrantResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RoleGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -127,16 +189,18 @@ func (r *RoleGrantResource) Read(ctx context.Context, req resource.ReadRequest,
 	role := strings.ToUpper(state.Role.ValueString())
 	grantee := strings.ToUpper(state.Grantee.ValueString())
 
-	// Check if role grant exists in EXA_DBA_ROLE_PRIVS
-	query := `SELECT ADMIN_OPTION FROM EXA_DBA_ROLE_PRIVS WHERE GRANTED_ROLE = ? AND GRANTEE = ?`
-	var adminOption string
-	err := r.db.QueryRowContext(ctx, query, role, grantee).Scan(&adminOption)
+	// Check if role grant exists in the role privilege view for the configured scope
+	query := fmt.Sprintf(`SELECT ADMIN_OPTION FROM %s WHERE GRANTED_ROLE = ? AND GRANTEE = ?`, rolePrivsView(r.viewScope))
+	var adminOption any
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx, query, role, grantee).Scan(&adminOption)
+	})
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Read role grant failed", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read role grant failed", err)
 		return
 	}
 
@@ -144,17 +208,27 @@ func (r *RoleGrantResource) Read(ctx context.Context, req resource.ReadRequest,
 	// If database has TRUE, set to true. If database has FALSE, set to null.
 	// This is because in Exasol, there's no distinction between "not specified" and "false"
 	// Both result in no admin option. This prevents drift when upgrading from old provider versions.
-	// Handle both uppercase (SaaS: "TRUE"/"1") and lowercase (Docker: "true") variants
-	if adminOption == "TRUE" || adminOption == "1" || adminOption == "true" {
+	if parseAdminOption(adminOption) {
 		state.WithAdminOption = types.BoolValue(true)
 	} else {
 		state.WithAdminOption = types.BoolNull()
 	}
 	state.ID = types.StringValue(roleGrantID(state))
+	setRoleGrantResolved(&state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *RoleGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_grant")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state roleGrantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -166,16 +240,21 @@ func (r *RoleGrantResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// If role or grantee changed, need to revoke old and grant new
-	if plan.Role.ValueString() != state.Role.ValueString() ||
-		plan.Grantee.ValueString() != state.Grantee.ValueString() {
+	// If role or grantee changed, need to revoke old and grant new. A case-only change of
+	// the spelling names the same role and grantee, so it needs no new grant.
+	if !strings.EqualFold(plan.Role.ValueString(), state.Role.ValueString()) ||
+		!strings.EqualFold(plan.Grantee.ValueString(), state.Grantee.ValueString()) {
 
 		// Revoke old role grant
 		oldRole := strings.ToUpper(state.Role.ValueString())
 		oldGrantee := strings.ToUpper(state.Grantee.ValueString())
-		revokeStmt := fmt.Sprintf(`REVOKE "%s" FROM "%s"`, oldRole, oldGrantee)
+		revokeStmt, err := buildRoleRevokeSQL(oldRole, oldGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid role grant", err.Error())
+			return
+		}
 		tflog.Info(ctx, "Revoking old role grant", map[string]any{"sql": revokeStmt})
-		if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 			resp.Diagnostics.AddError("REVOKE failed", err.Error())
 			return
 		}
@@ -183,12 +262,16 @@ func (r *RoleGrantResource) Update(ctx context.Context, req resource.UpdateReque
 		// Grant new role
 		newRole := strings.ToUpper(plan.Role.ValueString())
 		newGrantee := strings.ToUpper(plan.Grantee.ValueString())
-		grantStmt := fmt.Sprintf(`GRANT "%s" TO "%s"`, newRole, newGrantee)
+		grantStmt, err := buildRoleGrantSQL(newRole, newGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid role grant", err.Error())
+			return
+		}
 		if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 			grantStmt += " WITH ADMIN OPTION"
 		}
 		tflog.Info(ctx, "Granting new role", map[string]any{"sql": grantStmt})
-		if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 			resp.Diagnostics.AddError("GRANT failed", err.Error())
 			return
 		}
@@ -197,38 +280,57 @@ func (r *RoleGrantResource) Update(ctx context.Context, req resource.UpdateReque
 		role := strings.ToUpper(plan.Role.ValueString())
 		grantee := strings.ToUpper(plan.Grantee.ValueString())
 
-		revokeStmt := fmt.Sprintf(`REVOKE "%s" FROM "%s"`, role, grantee)
+		revokeStmt, err := buildRoleRevokeSQL(role, grantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid role grant", err.Error())
+			return
+		}
 		tflog.Info(ctx, "Revoking role to update admin option", map[string]any{"sql": revokeStmt})
-		if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 			resp.Diagnostics.AddError("REVOKE failed", err.Error())
 			return
 		}
 
-		grantStmt := fmt.Sprintf(`GRANT "%s" TO "%s"`, role, grantee)
+		grantStmt, err := buildRoleGrantSQL(role, grantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid role grant", err.Error())
+			return
+		}
 		if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 			grantStmt += " WITH ADMIN OPTION"
 		}
 		tflog.Info(ctx, "Re-granting role with updated admin option", map[string]any{"sql": grantStmt})
-		if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 			resp.Diagnostics.AddError("GRANT failed", err.Error())
 			return
 		}
 	}
 
 	plan.ID = types.StringValue(roleGrantID(plan))
+	setRoleGrantResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RoleGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role_grant")
 
 	var state roleGrantModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDelete(state.Protected, "exasol_role_grant "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -236,10 +338,14 @@ func (r *RoleGrantResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	role := strings.ToUpper(state.Role.ValueString())
 	grantee := strings.ToUpper(state.Grantee.ValueString())
-	stmt := fmt.Sprintf(`REVOKE "%s" FROM "%s"`, role, grantee)
+	stmt, err := buildRoleRevokeSQL(role, grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid role grant", err.Error())
+		return
+	}
 
 	tflog.Info(ctx, "Revoking role grant", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execRevoke(ctx, r.db, r.collisionRetries, stmt, r.ignoreMissingOnRevoke); err != nil {
 		resp.Diagnostics.AddError("REVOKE failed", err.Error())
 	}
 }
@@ -267,3 +373,33 @@ func roleGrantID(m roleGrantModel) string {
 	}
 	return fmt.Sprintf("%s|%s|%s", role, grantee, adminOption)
 }
+
+func buildRoleGrantSQL(role, grantee string, quoted bool) (string, error) {
+	roleIdent, err := quoteIdent(role, quoted)
+	if err != nil {
+		return "", err
+	}
+	granteeIdent, err := quoteIdent(grantee, quoted)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`GRANT %s TO %s`, roleIdent, granteeIdent), nil
+}
+
+func buildRoleRevokeSQL(role, grantee string, quoted bool) (string, error) {
+	roleIdent, err := quoteIdent(role, quoted)
+	if err != nil {
+		return "", err
+	}
+	granteeIdent, err := quoteIdent(grantee, quoted)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`REVOKE %s FROM %s`, roleIdent, granteeIdent), nil
+}
+
+// setRoleGrantResolved fills the computed resolved_* attributes from the normalized inputs.
+func setRoleGrantResolved(m *roleGrantModel) {
+	m.ResolvedRole = resolvedName(m.Role)
+	m.ResolvedGrantee = resolvedName(m.Grantee)
+}
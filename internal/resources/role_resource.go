@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
@@ -17,7 +18,15 @@ import (
 
 // RoleResource manages Exasol roles.
 type RoleResource struct {
-	db *sql.DB
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	viewScope            string
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
 }
 
 var _ resource.Resource = &RoleResource{}
@@ -39,6 +48,11 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Desired role name (case preserved in Terraform).",
 			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: commentDescription,
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Role name as stored in Exasol (always UPPERCASE).",
@@ -53,17 +67,37 @@ func (r *RoleResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
 	}
 }
 
 type roleModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Comment        types.String `tfsdk:"comment"`
+	LastAppliedSQL types.String `tfsdk:"last_applied_sql"`
 }
 
 func upper(s string) string { return strings.ToUpper(s) }
 
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan roleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -83,21 +117,35 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	stmt := fmt.Sprintf(`CREATE ROLE "%s"`, upName)
+	role, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid role name", err.Error())
+		return
+	}
+
+	stmt := fmt.Sprintf(`CREATE ROLE %s`, role)
 	tflog.Debug(ctx, "Creating role", map[string]any{"sql": stmt})
 	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
 		resp.Diagnostics.AddError("Error creating role", err.Error())
 		return
 	}
+	if err := applyComment(ctx, r.db, "ROLE", role, plan.Comment, types.StringNull()); err != nil {
+		resp.Diagnostics.AddError("Error setting role comment", err.Error())
+		return
+	}
 
 	// id must always match Exasol's actual name (upper case)
 	plan.ID = types.StringValue(upName)
 
 	// name remains exactly as user wrote it
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	var state roleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -109,23 +157,37 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	var current string
-	q := `SELECT ROLE_NAME FROM EXA_DBA_ROLES WHERE ROLE_NAME = ?`
-	err := r.db.QueryRowContext(ctx, q, state.ID.ValueString()).Scan(&current)
+	var comment sql.NullString
+	q := fmt.Sprintf(`SELECT ROLE_NAME, ROLE_COMMENT FROM %s WHERE ROLE_NAME = ?`, rolesView(r.viewScope))
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx, q, state.ID.ValueString()).Scan(&current, &comment)
+	})
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading role", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Error reading role", err)
 		return
 	}
 
-	// keep the user's spelling of name; only update id (upper-case in DB)
-	state.ID = types.StringValue(upper(current))
+	state.ID = types.StringValue(current)
+	state.Name = reconcileName(state.Name, current, upper)
+	state.Comment = reconcileComment(state.Comment, comment)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, prior roleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
@@ -153,7 +215,17 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if upNew != upOld {
-		stmt := fmt.Sprintf(`RENAME ROLE "%s" TO "%s"`, upOld, upNew)
+		oldRole, err := quoteIdent(upOld, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid old role name", err.Error())
+			return
+		}
+		newRole, err := quoteIdent(upNew, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid new role name", err.Error())
+			return
+		}
+		stmt := fmt.Sprintf(`RENAME ROLE %s TO %s`, oldRole, newRole)
 		tflog.Debug(ctx, "Renaming role", map[string]any{"sql": stmt})
 		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
 			resp.Diagnostics.AddError("Error renaming role", err.Error())
@@ -161,15 +233,32 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	role, err := quoteIdent(upNew, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid new role name", err.Error())
+		return
+	}
+	if err := applyComment(ctx, r.db, "ROLE", role, plan.Comment, prior.Comment); err != nil {
+		resp.Diagnostics.AddError("Error setting role comment", err.Error())
+		return
+	}
+
 	// Update id to match DB, keep name as in user config
 	plan.ID = types.StringValue(upNew)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, prior.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_role")
 
 	var state roleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -190,14 +279,20 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	stmt := fmt.Sprintf(`DROP ROLE "%s"`, upName)
+	role, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid role name", err.Error())
+		return
+	}
+
+	stmt := fmt.Sprintf(`DROP ROLE %s`, role)
 	tflog.Debug(ctx, "Dropping role", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
 		resp.Diagnostics.AddError("Error dropping role", err.Error())
 	}
 }
 
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by upper-case role name
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by role name; Read fills name from the stored spelling
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), upper(req.ID))...)
 }
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &RolesDataSource{}
+var _ datasource.DataSourceWithConfigure = &RolesDataSource{}
+
+// RolesDataSource lists roles from EXA_DBA_ROLES, or EXA_ALL_ROLES without DBA access.
+type RolesDataSource struct {
+	db          *sql.DB
+	readTimeout time.Duration
+}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+func (d *RolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists roles from EXA_DBA_ROLES, falling back to EXA_ALL_ROLES when the provider user cannot read " +
+			"the DBA view. No match is not an error: the lists are empty.",
+		Attributes: map[string]schema.Attribute{
+			"name_pattern": schema.StringAttribute{
+				Optional:    true,
+				Description: "LIKE pattern the role name must match, e.g. 'APP\\_%'. Role names are stored in UPPERCASE.",
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Role names, sorted.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Roles with their creation time, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: "Role name."},
+						"created": schema.StringAttribute{Computed: true, Description: "Creation timestamp, or null if not reported."},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name pattern, or * when listing all roles.",
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.readTimeout = c.ReadTimeout
+	}
+}
+
+type rolesModel struct {
+	ID          types.String    `tfsdk:"id"`
+	NamePattern types.String    `tfsdk:"name_pattern"`
+	Names       []types.String  `tfsdk:"names"`
+	Roles       []roleInfoModel `tfsdk:"roles"`
+}
+
+type roleInfoModel struct {
+	Name    types.String `tfsdk:"name"`
+	Created types.String `tfsdk:"created"`
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg rolesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg.ID = types.StringValue("*")
+	if !cfg.NamePattern.IsNull() {
+		cfg.ID = cfg.NamePattern
+	}
+	roles, err := d.listRoles(ctx, rolesView(ViewScopeDBA), cfg.NamePattern)
+	if err != nil {
+		tflog.Debug(ctx, "EXA_DBA_ROLES not readable, falling back to EXA_ALL_ROLES", map[string]any{"error": err.Error()})
+		roles, err = d.listRoles(ctx, rolesView(ViewScopeAll), cfg.NamePattern)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read roles failed", err.Error())
+		return
+	}
+
+	cfg.Roles = roles
+	cfg.Names = make([]types.String, len(roles))
+	for i, role := range roles {
+		cfg.Names[i] = role.Name
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// listRoles reads the roles of view, optionally those matching pattern. The result is never nil.
+func (d *RolesDataSource) listRoles(ctx context.Context, view string, pattern types.String) ([]roleInfoModel, error) {
+	query := `SELECT ROLE_NAME, CREATED FROM ` + view
+	var args []any
+	if !pattern.IsNull() {
+		query += ` WHERE ROLE_NAME LIKE ?`
+		args = append(args, pattern.ValueString())
+	}
+	query += ` ORDER BY ROLE_NAME`
+
+	roles := []roleInfoModel{}
+	err := retryRead(ctx, func() error {
+		roles = roles[:0]
+		rows, err := d.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			var created sql.NullString
+			if err := rows.Scan(&name, &created); err != nil {
+				return err
+			}
+			roles = append(roles, roleInfoModel{Name: types.StringValue(name), Created: nullableString(created)})
+		}
+		return rows.Err()
+	})
+	return roles, err
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,10 +19,20 @@ import (
 
 var _ resource.Resource = &SchemaResource{}
 var _ resource.ResourceWithImportState = &SchemaResource{}
+var _ resource.ResourceWithValidateConfig = &SchemaResource{}
 
 // SchemaResource manages Exasol schemas.
 type SchemaResource struct {
-	db *sql.DB
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	viewScope            string
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+	defaultCascade       bool
 }
 
 func NewSchemaResource() resource.Resource {
@@ -39,10 +52,34 @@ func (r *SchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Schema name to create or rename to.",
 			},
 			"owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Schema owner (user or role). If specified, ownership is transferred with ALTER SCHEMA ... CHANGE OWNER " +
+					"after creation and on change, and a different owner set outside Terraform shows up as drift. " +
+					"If omitted, the owner Exasol assigned (the creating user) is recorded and left alone.",
+			},
+			"comment": schema.StringAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Schema owner (user or role). If specified, ownership will be transferred after creation.",
+				Description: commentDescription,
+			},
+			"raw_size_limit": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum raw (uncompressed) data size of the schema in bytes (ALTER SCHEMA ... SET RAW_SIZE_LIMIT). " +
+					"Leave unset to not manage the limit; set to 0 to remove it. This is the only schema-level setting " +
+					"Exasol offers besides the owner.",
+			},
+			"cascade": schema.BoolAttribute{
+				Optional: true,
+				Description: "Drop the schema with CASCADE (true) or RESTRICT (false) on destroy. With RESTRICT, " +
+					"destroying a schema that still holds objects fails and lists them. " +
+					"Defaults to the provider's default_schema_cascade.",
 			},
+			"verify_rename": schema.BoolAttribute{
+				Optional: true,
+				Description: "After a rename, confirm the schema exists under the new name and warn if its grants " +
+					"did not follow. Exasol moves grants automatically; this catches older servers that do not.",
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Current schema name (used as Terraform ID).",
@@ -57,6 +94,15 @@ func (r *SchemaResource) Configure(_ context.Context, req resource.ConfigureRequ
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+		r.defaultCascade = c.DefaultSchemaCascade
 	}
 }
 
@@ -64,9 +110,41 @@ type schemaModel struct {
 	ID    types.String `tfsdk:"id"`
 	Name  types.String `tfsdk:"name"`
 	Owner types.String `tfsdk:"owner"`
+
+	Comment      types.String `tfsdk:"comment"`
+	RawSizeLimit types.Int64  `tfsdk:"raw_size_limit"`
+
+	Cascade        types.Bool   `tfsdk:"cascade"`
+	VerifyRename   types.Bool   `tfsdk:"verify_rename"`
+	LastAppliedSQL types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *SchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg schemaModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !cfg.RawSizeLimit.IsNull() && !cfg.RawSizeLimit.IsUnknown() && cfg.RawSizeLimit.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("raw_size_limit"), "Invalid raw_size_limit",
+			"raw_size_limit must be a number of bytes, or 0 to remove the limit.")
+	}
+	if !cfg.Owner.IsNull() && !cfg.Owner.IsUnknown() && !isValidIdentifier(cfg.Owner.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("owner"), "Invalid owner name", "Owner name must not be empty.")
+	}
 }
 
 func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_schema")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan schemaModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -86,22 +164,41 @@ func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	sqlStmt := fmt.Sprintf(`CREATE SCHEMA "%s"`, schemaName)
+	schemaIdent, err := quoteIdent(schemaName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid schema name", err.Error())
+		return
+	}
+
+	sqlStmt := fmt.Sprintf(`CREATE SCHEMA %s`, schemaIdent)
 	tflog.Info(ctx, "Creating schema", map[string]any{"sql": sqlStmt})
 	if _, err := r.db.ExecContext(ctx, sqlStmt); err != nil {
 		resp.Diagnostics.AddError("CREATE SCHEMA failed", err.Error())
 		return
 	}
+	if err := applyComment(ctx, r.db, "SCHEMA", schemaIdent, plan.Comment, types.StringNull()); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON SCHEMA failed", err.Error())
+		return
+	}
+	if err := applyRawSizeLimit(ctx, r.db, schemaIdent, plan.RawSizeLimit, types.Int64Null()); err != nil {
+		resp.Diagnostics.AddError("ALTER SCHEMA SET RAW_SIZE_LIMIT failed", err.Error())
+		return
+	}
 
 	// Transfer ownership if specified
 	if !plan.Owner.IsNull() && !plan.Owner.IsUnknown() {
-		owner := plan.Owner.ValueString()
+		// Users and roles are always stored uppercased, like the grantees of the grant resources
+		owner := strings.ToUpper(plan.Owner.ValueString())
 		if !isValidIdentifier(owner) {
-			resp.Diagnostics.AddError("Invalid owner name",
-				fmt.Sprintf("Owner name %q contains invalid characters.", owner))
+			resp.Diagnostics.AddError("Invalid owner name", "Owner name must not be empty.")
+			return
+		}
+		ownerIdent, err := quoteIdent(owner, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid owner name", err.Error())
 			return
 		}
-		alterStmt := fmt.Sprintf(`ALTER SCHEMA "%s" CHANGE OWNER "%s"`, schemaName, owner)
+		alterStmt := fmt.Sprintf(`ALTER SCHEMA %s CHANGE OWNER %s`, schemaIdent, ownerIdent)
 		tflog.Info(ctx, "Transferring schema ownership", map[string]any{"sql": alterStmt})
 		if _, err := r.db.ExecContext(ctx, alterStmt); err != nil {
 			resp.Diagnostics.AddError("ALTER SCHEMA CHANGE OWNER failed", err.Error())
@@ -109,11 +206,18 @@ func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	plan.ID = plan.Name
+	plan.ID = types.StringValue(canonicalIdent(schemaName, r.quoteIdentifiers))
+	if plan.Owner.IsUnknown() {
+		plan.Owner = readSchemaOwner(ctx, r.db, plan.ID.ValueString())
+	}
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *SchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	var state schemaModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -124,30 +228,88 @@ func (r *SchemaResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var owner sql.NullString
-	query := `SELECT SCHEMA_OWNER FROM EXA_ALL_SCHEMAS WHERE SCHEMA_NAME = ?`
-	err := r.db.QueryRowContext(ctx, query, state.ID.ValueString()).Scan(&owner)
+	schemaName := canonicalIdent(state.ID.ValueString(), r.quoteIdentifiers)
+	var row schemaRow
+	err := retryRead(ctx, func() error {
+		var err error
+		row, err = readSchemaRow(ctx, r.db, `s.SCHEMA_NAME = ?`, schemaName)
+		return err
+	})
+	if err == sql.ErrNoRows {
+		// The stored spelling may differ from id only in case, e.g. a schema created quoted
+		// in mixed case and tracked under its folded name. Adopt it instead of recreating.
+		var rows []schemaRow
+		err = retryRead(ctx, func() error {
+			var err error
+			rows, err = readSchemaRows(ctx, r.db, `UPPER(s.SCHEMA_NAME) = UPPER(?)`, schemaName)
+			return err
+		})
+		switch {
+		case err != nil:
+		case len(rows) == 0:
+			err = sql.ErrNoRows
+		case len(rows) > 1:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous schema name",
+				fmt.Sprintf("Schema %q was not found, and %d schemas match it case-insensitively. "+
+					"Set name to the exact spelling of the schema to manage.", schemaName, len(rows)))
+			return
+		default:
+			tflog.Info(ctx, "Schema found under a different case, correcting id", map[string]any{
+				"id": schemaName, "stored": rows[0].name,
+			})
+			row = rows[0]
+		}
+	}
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Read schema failed", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read schema failed", err)
+		return
+	}
+	schemaName = row.name
+	owner, comment, isVirtual := row.owner, row.comment, row.isVirtual
+	if isVirtual {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Virtual schema managed by exasol_schema",
+			fmt.Sprintf("Schema %q is a virtual schema. exasol_schema only manages regular schemas and would issue "+
+				"the wrong DDL for it. Remove it from state with `terraform state rm` and manage it outside this resource.", schemaName))
 		return
 	}
 
+	state.ID = types.StringValue(schemaName)
+	state.Name = reconcileName(state.Name, schemaName, func(n string) string { return canonicalIdent(n, r.quoteIdentifiers) })
+
 	// Update owner in state
 	if owner.Valid {
-		state.Owner = types.StringValue(owner.String)
+		state.Owner = reconcileName(state.Owner, owner.String, strings.ToUpper)
 	} else {
 		state.Owner = types.StringNull()
 	}
+	state.Comment = reconcileComment(state.Comment, comment)
+	if !state.RawSizeLimit.IsNull() {
+		limit, err := readRawSizeLimit(ctx, r.db, schemaName)
+		if err != nil {
+			readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read schema raw size limit failed", err)
+			return
+		}
+		state.RawSizeLimit = reconcileRawSizeLimit(state.RawSizeLimit, limit)
+	}
 
-	// Keep user-defined case in state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_schema")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state schemaModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -174,28 +336,67 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if oldName != newName {
-		sqlStmt := fmt.Sprintf(`RENAME SCHEMA "%s" TO "%s"`, oldName, newName)
+	oldIdent, err := quoteIdent(oldName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid old schema name", err.Error())
+		return
+	}
+	newIdent, err := quoteIdent(newName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid new schema name", err.Error())
+		return
+	}
+
+	oldCanonical := canonicalIdent(oldName, r.quoteIdentifiers)
+	newCanonical := canonicalIdent(newName, r.quoteIdentifiers)
+	if oldCanonical != newCanonical {
+		verify := plan.VerifyRename.ValueBool()
+		grantsBefore := 0
+		if verify {
+			grantsBefore, err = countSchemaGrants(ctx, r.db, r.viewScope, oldCanonical)
+			if err != nil {
+				resp.Diagnostics.AddError("Read schema grants failed", err.Error())
+				return
+			}
+		}
+
+		sqlStmt := fmt.Sprintf(`RENAME SCHEMA %s TO %s`, oldIdent, newIdent)
 		tflog.Info(ctx, "Renaming schema", map[string]any{"sql": sqlStmt})
 		if _, err := r.db.ExecContext(ctx, sqlStmt); err != nil {
 			resp.Diagnostics.AddError("RENAME SCHEMA failed", err.Error())
 			return
 		}
+		// Save the rename before the follow-up statements: if one of them fails, the next
+		// refresh must find the schema under its new name instead of planning a recreate.
+		state.ID = types.StringValue(newCanonical)
+		state.Name = plan.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		if verify {
+			resp.Diagnostics.Append(verifySchemaRename(ctx, r.db, r.viewScope, newCanonical, grantsBefore)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
 	// Handle ownership change
-	currentName := newName // Use new name if renamed, otherwise same as old
+	currentName := newIdent // Use new name if renamed, otherwise same as old
 	if !plan.Owner.IsNull() && !plan.Owner.IsUnknown() {
-		newOwner := plan.Owner.ValueString()
-		oldOwner := state.Owner.ValueString()
+		newOwner := strings.ToUpper(plan.Owner.ValueString())
+		oldOwner := strings.ToUpper(state.Owner.ValueString())
 
 		if newOwner != oldOwner {
 			if !isValidIdentifier(newOwner) {
-				resp.Diagnostics.AddError("Invalid owner name",
-					fmt.Sprintf("Owner name %q contains invalid characters.", newOwner))
+				resp.Diagnostics.AddError("Invalid owner name", "Owner name must not be empty.")
 				return
 			}
-			alterStmt := fmt.Sprintf(`ALTER SCHEMA "%s" CHANGE OWNER "%s"`, currentName, newOwner)
+			ownerIdent, err := quoteIdent(newOwner, r.quoteIdentifiers)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid owner name", err.Error())
+				return
+			}
+			alterStmt := fmt.Sprintf(`ALTER SCHEMA %s CHANGE OWNER %s`, currentName, ownerIdent)
 			tflog.Info(ctx, "Changing schema ownership", map[string]any{"sql": alterStmt})
 			if _, err := r.db.ExecContext(ctx, alterStmt); err != nil {
 				resp.Diagnostics.AddError("ALTER SCHEMA CHANGE OWNER failed", err.Error())
@@ -204,15 +405,34 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	if err := applyComment(ctx, r.db, "SCHEMA", currentName, plan.Comment, state.Comment); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON SCHEMA failed", err.Error())
+		return
+	}
+	if err := applyRawSizeLimit(ctx, r.db, currentName, plan.RawSizeLimit, state.RawSizeLimit); err != nil {
+		resp.Diagnostics.AddError("ALTER SCHEMA SET RAW_SIZE_LIMIT failed", err.Error())
+		return
+	}
+
 	// Update ID and Name to the new name
-	plan.ID = plan.Name
+	plan.ID = types.StringValue(canonicalIdent(newName, r.quoteIdentifiers))
+	if plan.Owner.IsUnknown() {
+		plan.Owner = readSchemaOwner(ctx, r.db, plan.ID.ValueString())
+	}
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_schema")
 
 	var state schemaModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -233,14 +453,215 @@ func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	sqlStmt := fmt.Sprintf(`DROP SCHEMA "%s" CASCADE`, schemaName)
+	schemaIdent, err := quoteIdent(schemaName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid schema name", err.Error())
+		return
+	}
+
+	cascade := resolveSchemaCascade(state.Cascade, r.defaultCascade)
+	sqlStmt := buildDropSchemaSQL(schemaIdent, cascade)
 	tflog.Info(ctx, "Dropping schema", map[string]any{"sql": sqlStmt})
-	if _, err := r.db.ExecContext(ctx, sqlStmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, sqlStmt); err != nil {
+		if !cascade {
+			if objects, listErr := schemaObjects(ctx, r.db, schemaName); listErr == nil && len(objects) > 0 {
+				resp.Diagnostics.AddError("Schema is not empty",
+					fmt.Sprintf("Schema %s was not dropped because cascade is false and it still contains: %s. "+
+						"Remove these objects, or set cascade = true to drop them with the schema.\n\nDROP SCHEMA failed: %s",
+						schemaName, strings.Join(objects, ", "), err.Error()))
+				return
+			}
+		}
 		resp.Diagnostics.AddError("DROP SCHEMA failed", err.Error())
 	}
 }
 
+// schemaObjectsLimit caps how many objects of a non-empty schema are listed in an error.
+const schemaObjectsLimit = 20
+
+// schemaObjects lists the objects of a schema as "TYPE NAME", for the error of a RESTRICT drop.
+func schemaObjects(ctx context.Context, db *sql.DB, schemaName string) ([]string, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT OBJECT_TYPE, OBJECT_NAME FROM EXA_ALL_OBJECTS WHERE ROOT_NAME = ? AND ROOT_TYPE = 'SCHEMA' ORDER BY OBJECT_TYPE, OBJECT_NAME`,
+		schemaName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var objects []string
+	for rows.Next() {
+		var objectType, name string
+		if err := rows.Scan(&objectType, &name); err != nil {
+			return nil, err
+		}
+		if len(objects) == schemaObjectsLimit {
+			objects = append(objects, "...")
+			break
+		}
+		objects = append(objects, objectType+" "+name)
+	}
+	return objects, rows.Err()
+}
+
 func (r *SchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// allow import by name
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by name; Read fills name from the stored spelling
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), canonicalIdent(req.ID, r.quoteIdentifiers))...)
+}
+
+// applyRawSizeLimit sets the schema's raw size limit when the managed value changed. Like comments,
+// a null plan leaves the limit alone; 0 removes it.
+func applyRawSizeLimit(ctx context.Context, db *sql.DB, ident string, plan, prior types.Int64) error {
+	if plan.IsNull() || plan.IsUnknown() || (!prior.IsNull() && prior.ValueInt64() == plan.ValueInt64()) {
+		return nil
+	}
+	limit := "NULL"
+	if plan.ValueInt64() > 0 {
+		limit = fmt.Sprintf("%d", plan.ValueInt64())
+	}
+	stmt := fmt.Sprintf(`ALTER SCHEMA %s SET RAW_SIZE_LIMIT = %s`, ident, limit)
+	tflog.Info(ctx, "Setting schema raw size limit", map[string]any{"sql": stmt})
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+// readRawSizeLimit reads RAW_OBJECT_SIZE_LIMIT of a schema; it is NULL when no limit is set.
+func readRawSizeLimit(ctx context.Context, db *sql.DB, schemaName string) (sql.NullFloat64, error) {
+	var limit sql.NullFloat64
+	query, args := buildObjectSizeQuery(schemaName, "")
+	var objectType string
+	var raw, mem sql.NullFloat64
+	err := retryRead(ctx, func() error {
+		return db.QueryRowContext(ctx, query, args...).Scan(&objectType, &raw, &mem, &limit)
+	})
+	if err == sql.ErrNoRows {
+		return sql.NullFloat64{}, nil
+	}
+	return limit, err
+}
+
+// reconcileRawSizeLimit maps RAW_OBJECT_SIZE_LIMIT back into state: unmanaged stays null and a
+// missing limit is reported as 0, matching a configured 0.
+func reconcileRawSizeLimit(configured types.Int64, actual sql.NullFloat64) types.Int64 {
+	if configured.IsNull() {
+		return types.Int64Null()
+	}
+	if !actual.Valid {
+		return types.Int64Value(0)
+	}
+	return types.Int64Value(int64(actual.Float64))
+}
+
+// schemaRow is one schema as read from EXA_ALL_SCHEMAS.
+type schemaRow struct {
+	name           string
+	owner, comment sql.NullString
+	isVirtual      bool
+}
+
+// readSchemaOwner returns the SCHEMA_OWNER of a schema for an owner left unset in the
+// configuration. A failed lookup is logged and gives null; the next Read fills it in.
+func readSchemaOwner(ctx context.Context, db *sql.DB, schemaName string) types.String {
+	row, err := readSchemaRow(ctx, db, `s.SCHEMA_NAME = ?`, schemaName)
+	if err != nil || !row.owner.Valid {
+		if err != nil {
+			tflog.Warn(ctx, "Could not read schema owner", map[string]any{"schema": schemaName, "error": err.Error()})
+		}
+		return types.StringNull()
+	}
+	return types.StringValue(row.owner.String)
+}
+
+// schemaRowsQuery reads schemas matching a WHERE condition. EXA_ALL_SCHEMAS also lists virtual
+// schemas, which exasol_schema cannot manage, so they are flagged.
+const schemaRowsQuery = `SELECT s.SCHEMA_NAME, s.SCHEMA_OWNER, s.SCHEMA_COMMENT,
+	CASE WHEN v.SCHEMA_NAME IS NULL THEN FALSE ELSE TRUE END
+	FROM EXA_ALL_SCHEMAS s LEFT JOIN EXA_ALL_VIRTUAL_SCHEMAS v ON v.SCHEMA_NAME = s.SCHEMA_NAME
+	WHERE `
+
+// readSchemaRow reads the single schema matching cond, or returns sql.ErrNoRows.
+func readSchemaRow(ctx context.Context, db *sql.DB, cond string, args ...any) (schemaRow, error) {
+	var row schemaRow
+	err := db.QueryRowContext(ctx, schemaRowsQuery+cond, args...).Scan(&row.name, &row.owner, &row.comment, &row.isVirtual)
+	return row, err
+}
+
+// readSchemaRows reads every schema matching cond.
+func readSchemaRows(ctx context.Context, db *sql.DB, cond string, args ...any) ([]schemaRow, error) {
+	rows, err := db.QueryContext(ctx, schemaRowsQuery+cond, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []schemaRow
+	for rows.Next() {
+		var row schemaRow
+		if err := rows.Scan(&row.name, &row.owner, &row.comment, &row.isVirtual); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// countSchemaGrants returns the number of object privileges granted on the schema itself.
+func countSchemaGrants(ctx context.Context, db *sql.DB, scope, schemaName string) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE OBJECT_TYPE = 'SCHEMA' AND OBJECT_NAME = ?`, objPrivsView(scope))
+	var count int
+	if err := db.QueryRowContext(ctx, query, schemaName).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// verifySchemaRename checks that a renamed schema is visible under its new name and that the
+// grants counted before the rename are still present. Missing grants only produce a warning,
+// since the rename itself has already been committed.
+func verifySchemaRename(ctx context.Context, db *sql.DB, scope, newName string, grantsBefore int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var found string
+	err := db.QueryRowContext(ctx, `SELECT SCHEMA_NAME FROM EXA_ALL_SCHEMAS WHERE SCHEMA_NAME = ?`, newName).Scan(&found)
+	if err == sql.ErrNoRows {
+		diags.AddError("Schema rename not visible",
+			fmt.Sprintf("RENAME SCHEMA succeeded but schema %q was not found in EXA_ALL_SCHEMAS.", newName))
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Verify schema rename failed", err.Error())
+		return diags
+	}
+
+	grantsAfter, err := countSchemaGrants(ctx, db, scope, newName)
+	if err != nil {
+		diags.AddWarning("Could not verify schema grants after rename", err.Error())
+		return diags
+	}
+	if grantsAfter < grantsBefore {
+		tflog.Warn(ctx, "Schema grants missing after rename", map[string]any{
+			"schema": newName,
+			"before": grantsBefore,
+			"after":  grantsAfter,
+		})
+		diags.AddWarning("Schema grants missing after rename",
+			fmt.Sprintf("Schema %q had %d grant(s) before the rename but %d under the new name in %s. "+
+				"Re-apply the affected exasol_object_privilege resources to restore them.",
+				newName, grantsBefore, grantsAfter, objPrivsView(scope)))
+	}
+	return diags
+}
+
+// resolveSchemaCascade returns the resource's cascade setting if set, otherwise the provider default.
+func resolveSchemaCascade(cascade types.Bool, providerDefault bool) bool {
+	if cascade.IsNull() || cascade.IsUnknown() {
+		return providerDefault
+	}
+	return cascade.ValueBool()
+}
+
+// buildDropSchemaSQL renders DROP SCHEMA for an already quoted identifier.
+func buildDropSchemaSQL(schemaIdent string, cascade bool) string {
+	if cascade {
+		return fmt.Sprintf(`DROP SCHEMA %s CASCADE`, schemaIdent)
+	}
+	return fmt.Sprintf(`DROP SCHEMA %s RESTRICT`, schemaIdent)
 }
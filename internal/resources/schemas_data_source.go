@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SchemasDataSource{}
+var _ datasource.DataSourceWithConfigure = &SchemasDataSource{}
+
+// SchemasDataSource lists the schemas visible to the provider user from EXA_ALL_SCHEMAS.
+type SchemasDataSource struct {
+	db               *sql.DB
+	quoteIdentifiers bool
+	readTimeout      time.Duration
+}
+
+func NewSchemasDataSource() datasource.DataSource {
+	return &SchemasDataSource{}
+}
+
+func (d *SchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schemas"
+}
+
+func (d *SchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the schemas in EXA_ALL_SCHEMAS, optionally only those of one owner. " +
+			"No match is not an error: names is an empty list.",
+		Attributes: map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list schemas owned by this user or role.",
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Schema names, sorted.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The owner filter, or * when listing all schemas.",
+			},
+		},
+	}
+}
+
+func (d *SchemasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.quoteIdentifiers = c.QuoteIdentifiers
+		d.readTimeout = c.ReadTimeout
+	}
+}
+
+type schemasModel struct {
+	ID    types.String `tfsdk:"id"`
+	Owner types.String `tfsdk:"owner"`
+	Names types.List   `tfsdk:"names"`
+}
+
+func (d *SchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg schemasModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := `SELECT SCHEMA_NAME FROM EXA_ALL_SCHEMAS`
+	var args []any
+	cfg.ID = types.StringValue("*")
+	if !cfg.Owner.IsNull() {
+		// Users and roles are always created uppercased by the provider
+		owner := strings.ToUpper(strings.Trim(cfg.Owner.ValueString(), `"`))
+		query += ` WHERE SCHEMA_OWNER = ?`
+		args = append(args, owner)
+		cfg.ID = types.StringValue(owner)
+	}
+	query += ` ORDER BY SCHEMA_NAME`
+
+	names, err := queryNames(ctx, d.db, query, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Read schemas failed", err.Error())
+		return
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	cfg.Names = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// queryNames runs a single-column query and returns its values, never nil, so an empty result
+// becomes an empty list rather than null.
+func queryNames(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	names := []string{}
+	err := retryRead(ctx, func() error {
+		names = names[:0]
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				return err
+			}
+			names = append(names, name)
+		}
+		return rows.Err()
+	})
+	return names, err
+}
@@ -0,0 +1,418 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ScriptResource manages UDF and adapter scripts.
+type ScriptResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+var _ resource.Resource = &ScriptResource{}
+var _ resource.ResourceWithImportState = &ScriptResource{}
+var _ resource.ResourceWithValidateConfig = &ScriptResource{}
+
+func NewScriptResource() resource.Resource { return &ScriptResource{} }
+
+// scriptTypes are the script types exasol_script manages. SCALAR and SET are UDFs and need a
+// signature; ADAPTER scripts back virtual schemas and have none.
+var scriptTypes = []string{"SCALAR", "SET", "ADAPTER"}
+
+func (r *ScriptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script"
+}
+
+func (r *ScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and drops an Exasol UDF (SCALAR or SET) or ADAPTER script. Changes are applied with " +
+			"CREATE OR REPLACE, which keeps the object privileges granted on the script.",
+		Attributes: map[string]schema.Attribute{
+			"schema_name": schema.StringAttribute{
+				Required: true,
+				Description: "Schema of the script, resolved like exasol_schema names: the exact spelling with quote_identifiers, " +
+					"uppercased otherwise. Changing it recreates the script.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(r.scriptSchemaMoved,
+						"The script is created anew under the new name.", "The script is created anew under the new name."),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Script name. It is stored uppercased; changing it other than in case recreates the script.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(scriptMoved,
+						"The script is created anew under the new name.", "The script is created anew under the new name."),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Script type: SCALAR, SET or ADAPTER.",
+			},
+			"language": schema.StringAttribute{
+				Required:    true,
+				Description: "Script language or language alias, e.g. PYTHON3, LUA, JAVA or R.",
+			},
+			"signature": schema.StringAttribute{
+				Optional: true,
+				Description: "Parameter list and RETURNS or EMITS clause of a SCALAR or SET script, e.g. " +
+					"\"(x DOUBLE) RETURNS DOUBLE\" or \"(line VARCHAR(2000)) EMITS (word VARCHAR(100))\". " +
+					"Required for SCALAR and SET, not allowed for ADAPTER. Not read back from the database.",
+			},
+			"content": schema.StringAttribute{
+				Required: true,
+				Description: "Script body following AS, passed to Exasol verbatim. A change made outside Terraform " +
+					"shows up as drift.",
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SCHEMA.SCRIPT as stored in Exasol (UPPERCASE).",
+			},
+		},
+	}
+}
+
+// scriptSchemaMoved replaces the script only when the schema resolves to a different stored name.
+func (r *ScriptResource) scriptSchemaMoved(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = canonicalIdent(req.StateValue.ValueString(), r.quoteIdentifiers) !=
+		canonicalIdent(req.PlanValue.ValueString(), r.quoteIdentifiers)
+}
+
+// scriptMoved replaces the script only when the stored script name changes;
+// spelling-only changes are applied in place.
+func scriptMoved(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = upper(req.StateValue.ValueString()) != upper(req.PlanValue.ValueString())
+}
+
+func (r *ScriptResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type scriptModel struct {
+	ID             types.String `tfsdk:"id"`
+	SchemaName     types.String `tfsdk:"schema_name"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Language       types.String `tfsdk:"language"`
+	Signature      types.String `tfsdk:"signature"`
+	Content        types.String `tfsdk:"content"`
+	LastAppliedSQL types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *ScriptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg scriptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for attr, v := range map[string]types.String{"schema_name": cfg.SchemaName, "name": cfg.Name, "language": cfg.Language} {
+		if !v.IsNull() && !v.IsUnknown() && !isValidIdentifier(upper(v.ValueString())) {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid "+attr,
+				fmt.Sprintf("%q must start with a letter and contain only letters, digits, and underscores.", v.ValueString()))
+		}
+	}
+	if cfg.Type.IsNull() || cfg.Type.IsUnknown() {
+		return
+	}
+	scriptType := upper(cfg.Type.ValueString())
+	if !slices.Contains(scriptTypes, scriptType) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid type",
+			fmt.Sprintf("type must be one of %s, got %q.", strings.Join(scriptTypes, ", "), cfg.Type.ValueString()))
+		return
+	}
+	if cfg.Signature.IsUnknown() {
+		return
+	}
+	switch hasSignature := !cfg.Signature.IsNull() && strings.TrimSpace(cfg.Signature.ValueString()) != ""; {
+	case scriptType == "ADAPTER" && hasSignature:
+		resp.Diagnostics.AddAttributeError(path.Root("signature"), "Unexpected signature",
+			"ADAPTER scripts have no parameters or return type; remove signature.")
+	case scriptType != "ADAPTER" && !hasSignature:
+		resp.Diagnostics.AddAttributeError(path.Root("signature"), "Missing signature",
+			fmt.Sprintf("%s scripts need a signature with the parameter list and a RETURNS or EMITS clause.", scriptType))
+	}
+}
+
+func (r *ScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_script")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan scriptModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	if !r.createOrReplace(ctx, &plan, resp.Diagnostics.AddError) {
+		return
+	}
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	var state scriptModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	schemaName, name, ok := strings.Cut(state.ID.ValueString(), ".")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid script ID", fmt.Sprintf("Expected SCHEMA.SCRIPT, got %q.", state.ID.ValueString()))
+		return
+	}
+
+	var scriptType, inputType, language, text sql.NullString
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx,
+			`SELECT SCRIPT_TYPE, SCRIPT_INPUT_TYPE, SCRIPT_LANGUAGE, SCRIPT_TEXT FROM EXA_ALL_SCRIPTS
+			WHERE SCRIPT_SCHEMA = ? AND SCRIPT_NAME = ?`, schemaName, name).
+			Scan(&scriptType, &inputType, &language, &text)
+	})
+	if err == sql.ErrNoRows {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read script failed", err)
+		return
+	}
+
+	state.SchemaName = reconcileName(state.SchemaName, schemaName, func(n string) string { return canonicalIdent(n, r.quoteIdentifiers) })
+	state.Name = reconcileName(state.Name, name, upper)
+	stored := inputType.String
+	if strings.EqualFold(scriptType.String, "ADAPTER") {
+		stored = "ADAPTER"
+	}
+	if stored != "" && !strings.EqualFold(state.Type.ValueString(), stored) {
+		state.Type = types.StringValue(stored)
+	}
+	if language.Valid && !strings.EqualFold(state.Language.ValueString(), language.String) {
+		state.Language = types.StringValue(language.String)
+	}
+	if body, ok := scriptBody(text.String); ok {
+		if state.Content.IsNull() || normalizeScriptBody(body) != normalizeScriptBody(state.Content.ValueString()) {
+			state.Content = types.StringValue(body)
+		}
+	} else {
+		tflog.Debug(ctx, "Could not find the script body in SCRIPT_TEXT; keeping content", map[string]any{"script": state.ID.ValueString()})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_script")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, prior scriptModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	// Schema and name changes replace the resource, so the script is the same one
+	if !upperEqual(plan.Type, prior.Type) || !upperEqual(plan.Language, prior.Language) ||
+		plan.Signature.ValueString() != prior.Signature.ValueString() ||
+		normalizeScriptBody(plan.Content.ValueString()) != normalizeScriptBody(prior.Content.ValueString()) {
+		if !r.createOrReplace(ctx, &plan, resp.Diagnostics.AddError) {
+			return
+		}
+	}
+	plan.ID = prior.ID
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, prior.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_script")
+
+	var state scriptModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	script, err := r.scriptIdent(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid script name", err.Error())
+		return
+	}
+	stmt := fmt.Sprintf(`DROP SCRIPT %s`, script)
+	tflog.Debug(ctx, "Dropping script", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		resp.Diagnostics.AddError("Error dropping script", err.Error())
+	}
+}
+
+func (r *ScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by SCHEMA.SCRIPT; Read fills the names, type, language and content. signature is
+	// not read back and has to be configured.
+	schemaName, name, ok := strings.Cut(req.ID, ".")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected SCHEMA.SCRIPT, got %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scriptID(schemaName, name, r.quoteIdentifiers))...)
+}
+
+// createOrReplace runs CREATE OR REPLACE SCRIPT for m and sets its ID.
+func (r *ScriptResource) createOrReplace(ctx context.Context, m *scriptModel, addError func(string, string)) bool {
+	id := scriptID(m.SchemaName.ValueString(), m.Name.ValueString(), r.quoteIdentifiers)
+	script, err := r.scriptIdent(id)
+	if err != nil {
+		addError("Invalid script name", err.Error())
+		return false
+	}
+	stmt, err := buildCreateScriptSQL(*m, script)
+	if err != nil {
+		addError("Invalid script", err.Error())
+		return false
+	}
+	tflog.Debug(ctx, "Creating script", map[string]any{"script": id})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		addError("Error creating script", err.Error())
+		return false
+	}
+	m.ID = types.StringValue(id)
+	return true
+}
+
+// scriptID is the SCHEMA.SCRIPT ID of a script as Exasol stores it. The schema is resolved like
+// exasol_schema resolves its name; script names are always uppercased.
+func scriptID(schemaName, name string, quoted bool) string {
+	return canonicalIdent(unquoteIdent(schemaName), quoted) + "." + upper(unquoteIdent(name))
+}
+
+// scriptIdent renders the SCHEMA.SCRIPT identifier of a script ID.
+func (r *ScriptResource) scriptIdent(id string) (string, error) {
+	schemaName, name, ok := strings.Cut(id, ".")
+	if !ok || !isValidIdentifier(schemaName) || !isValidIdentifier(name) {
+		return "", fmt.Errorf("script %q must be SCHEMA.SCRIPT with names of letters, digits, and underscores", id)
+	}
+	return qualifyIdent(id, r.quoteIdentifiers)
+}
+
+// buildCreateScriptSQL renders CREATE OR REPLACE SCRIPT. The content follows AS on its own line
+// unchanged: it is not a string literal, so nothing in it needs escaping.
+func buildCreateScriptSQL(m scriptModel, script string) (string, error) {
+	scriptType := upper(m.Type.ValueString())
+	if !slices.Contains(scriptTypes, scriptType) {
+		return "", fmt.Errorf("type must be one of %s", strings.Join(scriptTypes, ", "))
+	}
+	language := upper(m.Language.ValueString())
+	if !isValidIdentifier(language) {
+		return "", fmt.Errorf("invalid language %q", m.Language.ValueString())
+	}
+	header := fmt.Sprintf(`CREATE OR REPLACE %s %s SCRIPT %s`, language, scriptType, script)
+	if signature := strings.TrimSpace(m.Signature.ValueString()); signature != "" {
+		header += " " + signature
+	}
+	return header + " AS\n" + m.Content.ValueString(), nil
+}
+
+// scriptBodyStart matches the AS that ends a script header, followed by the line break
+// buildCreateScriptSQL puts before the content.
+var scriptBodyStart = regexp.MustCompile(`(?i)\bAS[ \t]*\r?\n`)
+
+// scriptBody returns the content of a script from its EXA_ALL_SCRIPTS.SCRIPT_TEXT, which holds
+// the whole CREATE statement.
+func scriptBody(text string) (string, bool) {
+	loc := scriptBodyStart.FindStringIndex(text)
+	if loc == nil {
+		return "", false
+	}
+	return text[loc[1]:], true
+}
+
+// normalizeScriptBody drops differences Exasol may introduce when storing a script: CRLF line
+// endings and trailing whitespace or a trailing "/" terminator.
+func normalizeScriptBody(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.TrimRight(s, " \t\n")
+	s = strings.TrimSuffix(s, "\n/")
+	return strings.TrimRight(s, " \t\n")
+}
+
+// upperEqual compares two string values case-insensitively.
+func upperEqual(a, b types.String) bool {
+	return strings.EqualFold(a.ValueString(), b.ValueString())
+}
@@ -14,16 +14,32 @@ func isValidIdentifier(name string) bool {
 	return name != ""
 }
 
+// regularIdentifierPattern matches identifiers that Exasol accepts without quotes.
+var regularIdentifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// isRegularIdentifier reports whether name can be used as an unquoted identifier.
+// Unquoted identifiers must start with a letter and contain only letters, digits
+// and underscores; Exasol folds them to uppercase.
+func isRegularIdentifier(name string) bool {
+	return regularIdentifierPattern.MatchString(name)
+}
+
 // sanitizeLogSQL redacts sensitive information (passwords) from SQL statements before logging.
 // This prevents passwords from appearing in logs.
 func sanitizeLogSQL(sql string) string {
 	// Redact passwords in CREATE/ALTER USER statements
 	// Pattern: IDENTIFIED BY "password" or IDENTIFIED BY 'password'
-	re := regexp.MustCompile(`(?i)(IDENTIFIED\s+BY\s+)["']([^"']+)["']`)
+	// Doubled quotes inside the literal ('' or "") are escapes and belong to the secret.
+	re := regexp.MustCompile(`(?i)(IDENTIFIED\s+BY\s+)('(?:[^']|'')*'|"(?:[^"]|"")*")`)
 	sanitized := re.ReplaceAllString(sql, `${1}"***REDACTED***"`)
 	return sanitized
 }
 
+// SanitizeSQL is sanitizeLogSQL for use outside this package, e.g. by the provider's SQL audit file.
+func SanitizeSQL(sql string) string {
+	return sanitizeLogSQL(sql)
+}
+
 // escapeStringLiteral escapes single quotes in string literals for SQL.
 // In SQL, single quotes are escaped by doubling them: ' becomes ”
 func escapeStringLiteral(s string) string {
@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SessionProfileDataSource{}
+var _ datasource.DataSourceWithConfigure = &SessionProfileDataSource{}
+
+// SessionProfileDataSource reads profiling output of a session from EXA_USER_PROFILE_LAST_DAY.
+type SessionProfileDataSource struct {
+	db          *sql.DB
+	readTimeout time.Duration
+}
+
+func NewSessionProfileDataSource() datasource.DataSource {
+	return &SessionProfileDataSource{}
+}
+
+func (d *SessionProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_profile"
+}
+
+func (d *SessionProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the profile of a session's statements from EXA_USER_PROFILE_LAST_DAY. Profiles are only " +
+			"recorded for sessions with profiling switched on (ALTER SESSION SET PROFILE = 'ON', or the provider's " +
+			"session_profiling argument) and show up after Exasol flushes its statistics (FLUSH STATISTICS).",
+		Attributes: map[string]schema.Attribute{
+			"session_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Session to read, as shown in EXA_ALL_SESSIONS.SESSION_ID.",
+			},
+			"stmt_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Restrict the result to one statement of the session.",
+			},
+			"parts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Profile parts ordered by statement and part ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"stmt_id":       schema.Int64Attribute{Computed: true, Description: "Statement ID within the session."},
+						"command_name":  schema.StringAttribute{Computed: true, Description: "Statement type, e.g. SELECT."},
+						"part_id":       schema.Int64Attribute{Computed: true, Description: "Execution part ID."},
+						"part_name":     schema.StringAttribute{Computed: true, Description: "Execution part, e.g. SCAN or GROUP BY."},
+						"part_info":     schema.StringAttribute{Computed: true, Description: "Extra information on the part."},
+						"object_schema": schema.StringAttribute{Computed: true, Description: "Schema of the processed object."},
+						"object_name":   schema.StringAttribute{Computed: true, Description: "Processed object."},
+						"object_rows":   schema.Int64Attribute{Computed: true, Description: "Rows of the processed object."},
+						"out_rows":      schema.Int64Attribute{Computed: true, Description: "Rows produced by the part."},
+						"duration":      schema.Float64Attribute{Computed: true, Description: "Duration of the part in seconds."},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SESSION_ID or SESSION_ID/STMT_ID.",
+			},
+		},
+	}
+}
+
+func (d *SessionProfileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.readTimeout = c.ReadTimeout
+	}
+}
+
+type sessionProfileModel struct {
+	ID        types.String       `tfsdk:"id"`
+	SessionID types.String       `tfsdk:"session_id"`
+	StmtID    types.Int64        `tfsdk:"stmt_id"`
+	Parts     []profilePartModel `tfsdk:"parts"`
+}
+
+type profilePartModel struct {
+	StmtID       types.Int64   `tfsdk:"stmt_id"`
+	CommandName  types.String  `tfsdk:"command_name"`
+	PartID       types.Int64   `tfsdk:"part_id"`
+	PartName     types.String  `tfsdk:"part_name"`
+	PartInfo     types.String  `tfsdk:"part_info"`
+	ObjectSchema types.String  `tfsdk:"object_schema"`
+	ObjectName   types.String  `tfsdk:"object_name"`
+	ObjectRows   types.Int64   `tfsdk:"object_rows"`
+	OutRows      types.Int64   `tfsdk:"out_rows"`
+	Duration     types.Float64 `tfsdk:"duration"`
+}
+
+func (d *SessionProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg sessionProfileModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessionID := strings.TrimSpace(cfg.SessionID.ValueString())
+	query, args := buildSessionProfileQuery(sessionID, cfg.StmtID)
+	cfg.ID = types.StringValue(sessionID)
+	if !cfg.StmtID.IsNull() {
+		cfg.ID = types.StringValue(fmt.Sprintf("%s/%d", sessionID, cfg.StmtID.ValueInt64()))
+	}
+
+	var parts []profilePartModel
+	err := retryRead(ctx, func() error {
+		parts = nil
+		rows, err := d.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var stmtID, partID sql.NullFloat64
+			var objectRows, outRows, duration sql.NullFloat64
+			var command, partName, partInfo, objectSchema, objectName sql.NullString
+			if err := rows.Scan(&stmtID, &command, &partID, &partName, &partInfo,
+				&objectSchema, &objectName, &objectRows, &outRows, &duration); err != nil {
+				return err
+			}
+			part := profilePartModel{
+				StmtID:       nullableInt64(stmtID),
+				CommandName:  nullableString(command),
+				PartID:       nullableInt64(partID),
+				PartName:     nullableString(partName),
+				PartInfo:     nullableString(partInfo),
+				ObjectSchema: nullableString(objectSchema),
+				ObjectName:   nullableString(objectName),
+				ObjectRows:   nullableInt64(objectRows),
+				OutRows:      nullableInt64(outRows),
+				Duration:     types.Float64Null(),
+			}
+			if duration.Valid {
+				part.Duration = types.Float64Value(duration.Float64)
+			}
+			parts = append(parts, part)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Read session profile failed", err.Error())
+		return
+	}
+
+	cfg.Parts = parts
+	if cfg.Parts == nil {
+		cfg.Parts = []profilePartModel{}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// buildSessionProfileQuery selects the profile parts of a session, optionally of one statement.
+// SESSION_ID is a DECIMAL(20,0) and may exceed int64, so it is passed as a string and cast.
+func buildSessionProfileQuery(sessionID string, stmtID types.Int64) (string, []any) {
+	query := `SELECT STMT_ID, COMMAND_NAME, PART_ID, PART_NAME, PART_INFO, OBJECT_SCHEMA, OBJECT_NAME,
+		OBJECT_ROWS, OUT_ROWS, DURATION
+		FROM EXA_USER_PROFILE_LAST_DAY WHERE SESSION_ID = CAST(? AS DECIMAL(20,0))`
+	args := []any{sessionID}
+	if !stmtID.IsNull() && !stmtID.IsUnknown() {
+		query += ` AND STMT_ID = ?`
+		args = append(args, stmtID.ValueInt64())
+	}
+	return query + ` ORDER BY STMT_ID, PART_ID`, args
+}
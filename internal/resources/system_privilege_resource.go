@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,11 +19,22 @@ import (
 
 var _ resource.Resource = &SystemPrivilegeResource{}
 var _ resource.ResourceWithImportState = &SystemPrivilegeResource{}
+var _ resource.ResourceWithValidateConfig = &SystemPrivilegeResource{}
 
 // SystemPrivilegeResource manages Exasol system privileges.
 // System privileges include: CREATE SESSION, CREATE TABLE, CREATE SCHEMA, etc.
 type SystemPrivilegeResource struct {
-	db *sql.DB
+	db                    *sql.DB
+	quoteIdentifiers      bool
+	viewScope             string
+	queryTimeout          time.Duration
+	readTimeout           time.Duration
+	deleteTimeout         time.Duration
+	collisionRetries      int
+	ignoreMissingOnRevoke bool
+	readPermissionPolicy  string
+	operations            *exasolclient.OperationLimiter
+	serverVersion         int
 }
 
 func NewSystemPrivilegeResource() resource.Resource {
@@ -45,10 +58,26 @@ func (r *SystemPrivilegeResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 				Description: "System privilege name (e.g., 'CREATE SESSION', 'CREATE TABLE', 'USE ANY SCHEMA').",
 			},
+			"acknowledge_broad_privilege": acknowledgeBroadPrivilegeAttribute(),
 			"with_admin_option": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Grant the privilege with ADMIN OPTION, allowing the grantee to grant this privilege to others.",
 			},
+			"check_connection_access": schema.BoolAttribute{
+				Optional: true,
+				Description: "When granting IMPORT or EXPORT, warn if the grantee has no direct connection grant, " +
+					"no ACCESS ON CONNECTION and no USE ANY CONNECTION privilege. IMPORT/EXPORT from a named connection needs both.",
+			},
+			"resolved_grantee": schema.StringAttribute{
+				Computed:    true,
+				Description: "Grantee name as stored in Exasol.",
+			},
+			"resolved_privilege": schema.StringAttribute{
+				Computed:    true,
+				Description: "Privilege name as stored in Exasol.",
+			},
+			"protected":        protectedAttribute(),
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Terraform ID in format: GRANTEE|PRIVILEGE|ADMIN_OPTION",
@@ -63,17 +92,57 @@ func (r *SystemPrivilegeResource) Configure(_ context.Context, req resource.Conf
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.viewScope = c.MetadataViewScope
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.ignoreMissingOnRevoke = c.IgnoreMissingOnRevoke
+		r.operations = c.Operations
+		r.serverVersion = c.ServerMajorVersion
 	}
 }
 
 type systemPrivilegeModel struct {
-	ID              types.String `tfsdk:"id"`
-	Grantee         types.String `tfsdk:"grantee"`
-	Privilege       types.String `tfsdk:"privilege"`
-	WithAdminOption types.Bool   `tfsdk:"with_admin_option"`
+	ID                types.String `tfsdk:"id"`
+	Grantee           types.String `tfsdk:"grantee"`
+	Privilege         types.String `tfsdk:"privilege"`
+	WithAdminOption   types.Bool   `tfsdk:"with_admin_option"`
+	AcknowledgeBroad  types.Bool   `tfsdk:"acknowledge_broad_privilege"`
+	CheckConnection   types.Bool   `tfsdk:"check_connection_access"`
+	ResolvedGrantee   types.String `tfsdk:"resolved_grantee"`
+	ResolvedPrivilege types.String `tfsdk:"resolved_privilege"`
+	Protected         types.Bool   `tfsdk:"protected"`
+	LastAppliedSQL    types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *SystemPrivilegeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg systemPrivilegeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rejectPublicAdminOption(&resp.Diagnostics, path.Root("grantee"), cfg.Grantee, cfg.WithAdminOption)
+	if !cfg.Privilege.IsNull() && !cfg.Privilege.IsUnknown() && !isValidPrivilegeName(normalizePrivilege(cfg.Privilege.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("privilege"), "Invalid privilege",
+			fmt.Sprintf("Privilege %q must consist of words of letters separated by spaces, e.g. CREATE SESSION.", cfg.Privilege.ValueString()))
+	}
+	warnBroadPrivilege(&resp.Diagnostics, path.Root("privilege"), cfg.Privilege, cfg.AcknowledgeBroad)
 }
 
 func (r *SystemPrivilegeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_system_privilege")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan systemPrivilegeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -85,7 +154,8 @@ func (r *SystemPrivilegeResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	grantee := strings.ToUpper(plan.Grantee.ValueString())
-	privilege := strings.ToUpper(plan.Privilege.ValueString())
+	privilege := normalizePrivilege(plan.Privilege.ValueString())
+	warnUnknownSystemPrivilege(&resp.Diagnostics, r.serverVersion, privilege)
 
 	// Validate identifiers
 	if !isValidIdentifier(grantee) {
@@ -93,23 +163,37 @@ func (r *SystemPrivilegeResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	granteeIdent, err := quoteIdent(grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
+
 	// Build GRANT statement
-	stmt := fmt.Sprintf(`GRANT %s TO "%s"`, privilege, grantee)
+	stmt := fmt.Sprintf(`GRANT %s TO %s`, privilege, granteeIdent)
 	if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 		stmt += " WITH ADMIN OPTION"
 	}
 
 	tflog.Info(ctx, "Granting system privilege", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
 		resp.Diagnostics.AddError("GRANT failed", err.Error())
 		return
 	}
+	if plan.CheckConnection.ValueBool() {
+		warnMissingConnectionAccess(ctx, r.db, r.viewScope, &resp.Diagnostics, grantee, privilege)
+	}
 
 	plan.ID = types.StringValue(systemPrivilegeID(plan))
+	setSystemPrivilegeResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *SystemPrivilegeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -122,18 +206,20 @@ func (r *SystemPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	grantee := strings.ToUpper(state.Grantee.ValueString())
-	privilege := strings.ToUpper(state.Privilege.ValueString())
+	privilege := normalizePrivilege(state.Privilege.ValueString())
 
 	// Check if privilege exists in EXA_DBA_SYS_PRIVS
 	query := `SELECT ADMIN_OPTION FROM EXA_DBA_SYS_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = ?`
-	var adminOption string
-	err := r.db.QueryRowContext(ctx, query, grantee, privilege).Scan(&adminOption)
+	var adminOption any
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx, query, grantee, privilege).Scan(&adminOption)
+	})
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Read system privilege failed", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read system privilege failed", err)
 		return
 	}
 
@@ -141,17 +227,27 @@ func (r *SystemPrivilegeResource) Read(ctx context.Context, req resource.ReadReq
 	// If database has TRUE, set to true. If database has FALSE, set to null.
 	// This is because in Exasol, there's no distinction between "not specified" and "false"
 	// Both result in no admin option. This prevents drift when upgrading from old provider versions.
-	// Handle both uppercase (SaaS: "TRUE"/"1") and lowercase (Docker: "true") variants
-	if adminOption == "TRUE" || adminOption == "1" || adminOption == "true" {
+	if parseAdminOption(adminOption) {
 		state.WithAdminOption = types.BoolValue(true)
 	} else {
 		state.WithAdminOption = types.BoolNull()
 	}
 	state.ID = types.StringValue(systemPrivilegeID(state))
+	setSystemPrivilegeResolved(&state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *SystemPrivilegeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_system_privilege")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state systemPrivilegeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -163,80 +259,116 @@ func (r *SystemPrivilegeResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// If grantee or privilege changed, need to revoke old and grant new
-	if plan.Grantee.ValueString() != state.Grantee.ValueString() ||
-		plan.Privilege.ValueString() != state.Privilege.ValueString() {
+	// If grantee or privilege changed, need to revoke old and grant new (case-only changes
+	// of the grantee spelling name the same grantee)
+	if !strings.EqualFold(plan.Grantee.ValueString(), state.Grantee.ValueString()) ||
+		normalizePrivilege(plan.Privilege.ValueString()) != normalizePrivilege(state.Privilege.ValueString()) {
 
 		// Revoke old privilege
 		oldGrantee := strings.ToUpper(state.Grantee.ValueString())
-		oldPrivilege := strings.ToUpper(state.Privilege.ValueString())
-		revokeStmt := fmt.Sprintf(`REVOKE %s FROM "%s"`, oldPrivilege, oldGrantee)
+		oldPrivilege := normalizePrivilege(state.Privilege.ValueString())
+		oldGranteeIdent, err := quoteIdent(oldGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid grantee", err.Error())
+			return
+		}
+		revokeStmt := fmt.Sprintf(`REVOKE %s FROM %s`, oldPrivilege, oldGranteeIdent)
 		tflog.Info(ctx, "Revoking old system privilege", map[string]any{"sql": revokeStmt})
-		if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 			resp.Diagnostics.AddError("REVOKE failed", err.Error())
 			return
 		}
 
 		// Grant new privilege
 		newGrantee := strings.ToUpper(plan.Grantee.ValueString())
-		newPrivilege := strings.ToUpper(plan.Privilege.ValueString())
-		grantStmt := fmt.Sprintf(`GRANT %s TO "%s"`, newPrivilege, newGrantee)
+		newPrivilege := normalizePrivilege(plan.Privilege.ValueString())
+		warnUnknownSystemPrivilege(&resp.Diagnostics, r.serverVersion, newPrivilege)
+		newGranteeIdent, err := quoteIdent(newGrantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid grantee", err.Error())
+			return
+		}
+		grantStmt := fmt.Sprintf(`GRANT %s TO %s`, newPrivilege, newGranteeIdent)
 		if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 			grantStmt += " WITH ADMIN OPTION"
 		}
 		tflog.Info(ctx, "Granting new system privilege", map[string]any{"sql": grantStmt})
-		if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 			resp.Diagnostics.AddError("GRANT failed", err.Error())
 			return
 		}
+		if plan.CheckConnection.ValueBool() {
+			warnMissingConnectionAccess(ctx, r.db, r.viewScope, &resp.Diagnostics, newGrantee, newPrivilege)
+		}
 	} else if plan.WithAdminOption.ValueBool() != state.WithAdminOption.ValueBool() {
 		// Only admin option changed - need to revoke and re-grant
 		grantee := strings.ToUpper(plan.Grantee.ValueString())
-		privilege := strings.ToUpper(plan.Privilege.ValueString())
+		privilege := normalizePrivilege(plan.Privilege.ValueString())
+		granteeIdent, err := quoteIdent(grantee, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid grantee", err.Error())
+			return
+		}
 
-		revokeStmt := fmt.Sprintf(`REVOKE %s FROM "%s"`, privilege, grantee)
+		revokeStmt := fmt.Sprintf(`REVOKE %s FROM %s`, privilege, granteeIdent)
 		tflog.Info(ctx, "Revoking system privilege to update admin option", map[string]any{"sql": revokeStmt})
-		if _, err := r.db.ExecContext(ctx, revokeStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, revokeStmt); err != nil {
 			resp.Diagnostics.AddError("REVOKE failed", err.Error())
 			return
 		}
 
-		grantStmt := fmt.Sprintf(`GRANT %s TO "%s"`, privilege, grantee)
+		grantStmt := fmt.Sprintf(`GRANT %s TO %s`, privilege, granteeIdent)
 		if !plan.WithAdminOption.IsNull() && plan.WithAdminOption.ValueBool() {
 			grantStmt += " WITH ADMIN OPTION"
 		}
 		tflog.Info(ctx, "Re-granting system privilege with updated admin option", map[string]any{"sql": grantStmt})
-		if _, err := r.db.ExecContext(ctx, grantStmt); err != nil {
+		if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, grantStmt); err != nil {
 			resp.Diagnostics.AddError("GRANT failed", err.Error())
 			return
 		}
 	}
 
 	plan.ID = types.StringValue(systemPrivilegeID(plan))
+	setSystemPrivilegeResolved(&plan)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *SystemPrivilegeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_system_privilege")
 
 	var state systemPrivilegeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDelete(state.Protected, "exasol_system_privilege "+state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
 	}
 
 	grantee := strings.ToUpper(state.Grantee.ValueString())
-	privilege := strings.ToUpper(state.Privilege.ValueString())
-	stmt := fmt.Sprintf(`REVOKE %s FROM "%s"`, privilege, grantee)
+	privilege := normalizePrivilege(state.Privilege.ValueString())
+	granteeIdent, err := quoteIdent(grantee, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grantee", err.Error())
+		return
+	}
+	stmt := fmt.Sprintf(`REVOKE %s FROM %s`, privilege, granteeIdent)
 
 	tflog.Info(ctx, "Revoking system privilege", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execRevoke(ctx, r.db, r.collisionRetries, stmt, r.ignoreMissingOnRevoke); err != nil {
 		resp.Diagnostics.AddError("REVOKE failed", err.Error())
 	}
 }
@@ -257,10 +389,41 @@ func (r *SystemPrivilegeResource) ImportState(ctx context.Context, req resource.
 
 func systemPrivilegeID(m systemPrivilegeModel) string {
 	grantee := strings.ToUpper(m.Grantee.ValueString())
-	privilege := strings.ToUpper(m.Privilege.ValueString())
+	privilege := normalizePrivilege(m.Privilege.ValueString())
 	adminOption := "false"
 	if !m.WithAdminOption.IsNull() && m.WithAdminOption.ValueBool() {
 		adminOption = "true"
 	}
 	return fmt.Sprintf("%s|%s|%s", grantee, privilege, adminOption)
 }
+
+// setSystemPrivilegeResolved fills the computed resolved_* attributes from the normalized inputs.
+func setSystemPrivilegeResolved(m *systemPrivilegeModel) {
+	m.ResolvedGrantee = resolvedName(m.Grantee)
+	m.ResolvedPrivilege = types.StringValue(normalizePrivilege(m.Privilege.ValueString()))
+}
+
+// warnMissingConnectionAccess adds a warning when IMPORT or EXPORT is granted to a grantee that
+// holds no direct connection grant, no ACCESS ON CONNECTION and no USE ANY CONNECTION privilege,
+// a common misconfiguration. Access inherited through roles is not considered. Query failures only
+// log, since the grant succeeded.
+func warnMissingConnectionAccess(ctx context.Context, db *sql.DB, scope string, diags *diag.Diagnostics, grantee, privilege string) {
+	if privilege != "IMPORT" && privilege != "EXPORT" {
+		return
+	}
+	query := fmt.Sprintf(`SELECT (SELECT COUNT(*) FROM %s WHERE GRANTEE = ?) +
+		(SELECT COUNT(*) FROM %s WHERE GRANTEE = ? AND OBJECT_TYPE = 'CONNECTION' AND PRIVILEGE = 'ACCESS') +
+		(SELECT COUNT(*) FROM %s WHERE GRANTEE = ? AND PRIVILEGE = 'USE ANY CONNECTION')`,
+		connectionPrivsView(scope), objPrivsView(scope), sysPrivsView(scope))
+	var count int
+	if err := db.QueryRowContext(ctx, query, grantee, grantee, grantee).Scan(&count); err != nil {
+		tflog.Warn(ctx, "Could not check connection access", map[string]any{"grantee": grantee, "error": err.Error()})
+		return
+	}
+	if count == 0 {
+		diags.AddAttributeWarning(path.Root("grantee"), "Grantee has no connection access",
+			fmt.Sprintf("%s was granted %s but has no connection grant, no ACCESS ON CONNECTION and no USE ANY CONNECTION privilege. "+
+				"IMPORT/EXPORT from a named connection will fail until an exasol_connection_grant is added "+
+				"(grants inherited through roles are not checked).", grantee, privilege))
+	}
+}
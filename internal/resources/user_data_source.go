@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigure = &UserDataSource{}
+
+// UserDataSource reads an existing user, e.g. one created outside Terraform.
+type UserDataSource struct {
+	db               *sql.DB
+	quoteIdentifiers bool
+	readTimeout      time.Duration
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing Exasol user from EXA_ALL_USERS. A missing user is an error. " +
+			"auth_type and account_locked need access to EXA_DBA_USERS and EXA_DBA_SYS_PRIVS and are null without it.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "User name.",
+			},
+			"auth_type": schema.StringAttribute{
+				Computed:    true,
+				Description: `Authentication type: "PASSWORD", "LDAP", "KERBEROS" or "OPENID".`,
+			},
+			"consumer_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "Consumer group assigned to the user itself, or null if none.",
+			},
+			"account_locked": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the user has no CREATE SESSION privilege of its own (see exasol_user.account_locked).",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "User name as stored in Exasol.",
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		d.db = c.DB
+		d.quoteIdentifiers = c.QuoteIdentifiers
+		d.readTimeout = c.ReadTimeout
+	}
+}
+
+type userDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	AuthType      types.String `tfsdk:"auth_type"`
+	ConsumerGroup types.String `tfsdk:"consumer_group"`
+	AccountLocked types.Bool   `tfsdk:"account_locked"`
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.readTimeout)
+	defer cancel()
+
+	if d.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var cfg userDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// exasol_user always creates users uppercased
+	name := strings.ToUpper(strings.Trim(cfg.Name.ValueString(), `"`))
+	var stored string
+	var consumerGroup sql.NullString
+	err := retryRead(ctx, func() error {
+		return d.db.QueryRowContext(ctx,
+			`SELECT USER_NAME, USER_CONSUMER_GROUP FROM EXA_ALL_USERS WHERE USER_NAME = ?`, name).
+			Scan(&stored, &consumerGroup)
+	})
+	if err == sql.ErrNoRows {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "User not found",
+			fmt.Sprintf("User %q does not exist in EXA_ALL_USERS.", name))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read user failed", err.Error())
+		return
+	}
+
+	cfg.ID = types.StringValue(stored)
+	cfg.ConsumerGroup = nullableString(consumerGroup)
+	cfg.AuthType = types.StringNull()
+	if authType, _, err := detectUserAuthType(ctx, d.db, stored); err != nil {
+		tflog.Debug(ctx, "Could not detect user auth type", map[string]any{"user": stored, "error": err.Error()})
+	} else {
+		cfg.AuthType = types.StringValue(authType)
+	}
+	cfg.AccountLocked = types.BoolNull()
+	if canLogIn, err := hasDirectCreateSession(ctx, d.db, stored); err != nil {
+		tflog.Debug(ctx, "Could not read CREATE SESSION grant", map[string]any{"user": stored, "error": err.Error()})
+	} else {
+		cfg.AccountLocked = types.BoolValue(!canLogIn)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"terraform-provider-exasol/internal/exasolclient"
 
@@ -21,7 +23,14 @@ var _ resource.ResourceWithImportState = &UserResource{}
 // UserResource manages Exasol database users.
 // It supports password, LDAP and OpenID authentication types.
 type UserResource struct {
-	db *sql.DB
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
 }
 
 func NewUserResource() resource.Resource { return &UserResource{} }
@@ -38,19 +47,26 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "User name. Exasol user names are case-insensitive.",
 			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Terraform ID — always set to the user name in uppercase.",
 			},
 			"auth_type": schema.StringAttribute{
-				Required:    true,
-				Description: `Authentication type: "PASSWORD", "LDAP" or "OPENID".`,
+				Required: true,
+				Description: `Authentication type: "PASSWORD", "LDAP" or "OPENID". Read detects it from EXA_DBA_USERS ` +
+					`(and reports "KERBEROS" for Kerberos users), so auth changes made outside Terraform show up as drift.`,
 			},
 			"password": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
 				Description: "Password for PASSWORD authentication.",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional: true,
+				Description: "Any number; changing it re-sets the password (ALTER USER ... IDENTIFIED BY) even if password " +
+					"itself is unchanged. Passwords cannot be read back, so bump it to rotate from an external schedule.",
+			},
 			"ldap_dn": schema.StringAttribute{
 				Optional:    true,
 				Description: "LDAP distinguished name if auth_type is LDAP.",
@@ -59,6 +75,32 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Description: "OpenID subject if auth_type is OPENID.",
 			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: commentDescription,
+			},
+			"consumer_group": schema.StringAttribute{
+				Optional: true,
+				Description: "Consumer group assigned to the user (ALTER USER ... SET CONSUMER_GROUP). Removing it " +
+					"resets the user to no consumer group, so its sessions fall back to its roles' groups or the default.",
+			},
+			"account_locked": schema.BoolAttribute{
+				Optional: true,
+				Description: "Lock the user out by revoking its CREATE SESSION privilege (true) or allow logins by granting it " +
+					"(false). Exasol has no separate account lock, so CREATE SESSION obtained through a role still lets the user " +
+					"log in, and the privilege must not also be managed with exasol_system_privilege. Leave unset to not manage it.",
+			},
+			"password_expiry_days": schema.Int64Attribute{
+				Optional: true,
+				Description: "Days after which the user's password expires (PASSWORD_EXPIRY_POLICY = 'EXPIRY_DAYS=n'). " +
+					"0 disables expiry for the user. Leave unset to not manage it.",
+			},
+			"effective_consumer_group": schema.StringAttribute{
+				Computed: true,
+				Description: "Consumer group the user's sessions run under: the user's own consumer group, else the group " +
+					"with the highest precedence among its directly granted roles, else the database DEFAULT_CONSUMER_GROUP. " +
+					"Null if it cannot be determined (Exasol before 7.0, or no access to the EXA_DBA_* views).",
+			},
 		},
 	}
 }
@@ -69,19 +111,43 @@ func (r *UserResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
 		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
 	}
 }
 
 type userModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	AuthType      types.String `tfsdk:"auth_type"`
-	Password      types.String `tfsdk:"password"`
-	LDAPDN        types.String `tfsdk:"ldap_dn"`
-	OpenIDSubject types.String `tfsdk:"openid_subject"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	AuthType               types.String `tfsdk:"auth_type"`
+	Password               types.String `tfsdk:"password"`
+	PasswordVersion        types.Int64  `tfsdk:"password_version"`
+	LDAPDN                 types.String `tfsdk:"ldap_dn"`
+	OpenIDSubject          types.String `tfsdk:"openid_subject"`
+	Comment                types.String `tfsdk:"comment"`
+	ConsumerGroup          types.String `tfsdk:"consumer_group"`
+	AccountLocked          types.Bool   `tfsdk:"account_locked"`
+	PasswordExpiryDays     types.Int64  `tfsdk:"password_expiry_days"`
+	LastAppliedSQL         types.String `tfsdk:"last_applied_sql"`
+	EffectiveConsumerGroup types.String `tfsdk:"effective_consumer_group"`
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_user")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan userModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -100,7 +166,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	sqlStmt, err := buildCreateUserSQL(plan)
+	sqlStmt, err := buildCreateUserSQL(plan, r.quoteIdentifiers)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid user configuration", err.Error())
 		return
@@ -111,20 +177,43 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// also grant CREATE SESSION so user can log in
-	escapedName := escapeIdentifierLiteral(upName)
-	grant := fmt.Sprintf(`GRANT CREATE SESSION TO "%s"`, escapedName)
-	if _, err := r.db.ExecContext(ctx, grant); err != nil {
-		resp.Diagnostics.AddError("Grant CREATE SESSION failed", err.Error())
+	// also grant CREATE SESSION so user can log in, unless it is created locked
+	user, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user name", err.Error())
+		return
+	}
+	if !plan.AccountLocked.ValueBool() {
+		grant := fmt.Sprintf(`GRANT CREATE SESSION TO %s`, user)
+		if _, err := r.db.ExecContext(ctx, grant); err != nil {
+			resp.Diagnostics.AddError("Grant CREATE SESSION failed", err.Error())
+			return
+		}
+	}
+	if err := applyComment(ctx, r.db, "USER", user, plan.Comment, types.StringNull()); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON USER failed", err.Error())
+		return
+	}
+	if err := applyUserConsumerGroup(ctx, r.db, user, plan.ConsumerGroup, types.StringNull(), r.quoteIdentifiers); err != nil {
+		resp.Diagnostics.AddError("ALTER USER SET CONSUMER_GROUP failed", err.Error())
+		return
+	}
+	if err := applyPasswordExpiry(ctx, r.db, user, plan.PasswordExpiryDays, types.Int64Null()); err != nil {
+		resp.Diagnostics.AddError("ALTER USER SET PASSWORD_EXPIRY_POLICY failed", err.Error())
 		return
 	}
 
 	plan.ID = types.StringValue(upName)
+	plan.EffectiveConsumerGroup = readEffectiveConsumerGroup(ctx, r.db, upName)
 	// Keep original name - don't uppercase it (Terraform expects consistency)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
 	if r.db == nil {
 		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
 		return
@@ -135,24 +224,61 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	var dummy int
-	err := r.db.QueryRowContext(ctx,
-		`SELECT 1 FROM EXA_ALL_USERS WHERE USER_NAME = ?`,
-		state.ID.ValueString()).Scan(&dummy)
+	var comment sql.NullString
+	err := retryRead(ctx, func() error {
+		return r.db.QueryRowContext(ctx,
+			`SELECT USER_COMMENT FROM EXA_ALL_USERS WHERE USER_NAME = ?`,
+			state.ID.ValueString()).Scan(&comment)
+	})
 	if err == sql.ErrNoRows {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Read user failed", err.Error())
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read user failed", err)
 		return
 	}
-	// keep original attributes except we always keep ID uppercase
-	state.ID = types.StringValue(strings.ToUpper(state.Name.ValueString()))
+	state.Name = reconcileName(state.Name, state.ID.ValueString(), strings.ToUpper)
+	state.Comment = reconcileComment(state.Comment, comment)
+	// Consumer groups exist from Exasol 7.0 and PASSWORD_EXPIRY_POLICY only in EXA_DBA_USERS;
+	// where they cannot be read the configured values are kept as is
+	if consumerGroup, ok := readUserColumn(ctx, r.db, "EXA_ALL_USERS", "USER_CONSUMER_GROUP", state.ID.ValueString()); ok {
+		state.ConsumerGroup = reconcileUserConsumerGroup(state.ConsumerGroup, consumerGroup)
+	}
+	if !state.PasswordExpiryDays.IsNull() {
+		if expiryPolicy, ok := readUserColumn(ctx, r.db, "EXA_DBA_USERS", "PASSWORD_EXPIRY_POLICY", state.ID.ValueString()); ok {
+			state.PasswordExpiryDays = types.Int64Value(passwordExpiryDays(expiryPolicy))
+		}
+	}
+	if !state.AccountLocked.IsNull() {
+		canLogIn, err := hasDirectCreateSession(ctx, r.db, state.ID.ValueString())
+		if err != nil {
+			readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read user failed", err)
+			return
+		}
+		state.AccountLocked = types.BoolValue(!canLogIn)
+	}
+	state.EffectiveConsumerGroup = readEffectiveConsumerGroup(ctx, r.db, state.ID.ValueString())
+	// Auth drift needs EXA_DBA_USERS; without access the configured auth is kept as is
+	if authType, detail, err := detectUserAuthType(ctx, r.db, state.ID.ValueString()); err != nil {
+		tflog.Debug(ctx, "Could not detect user auth type", map[string]any{"user": state.ID.ValueString(), "error": err.Error()})
+	} else {
+		reconcileUserAuth(&state, authType, detail)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_user")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
 	var plan, state userModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -177,12 +303,18 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Escape usernames for use in quoted identifiers
-	escapedOld := escapeIdentifierLiteral(upOld)
-	escapedNew := escapeIdentifierLiteral(upNew)
-
 	if upOld != upNew {
-		stmt := fmt.Sprintf(`RENAME USER "%s" TO "%s"`, escapedOld, escapedNew)
+		oldUser, err := quoteIdent(upOld, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid old user name", err.Error())
+			return
+		}
+		newUser, err := quoteIdent(upNew, r.quoteIdentifiers)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid new user name", err.Error())
+			return
+		}
+		stmt := fmt.Sprintf(`RENAME USER %s TO %s`, oldUser, newUser)
 		tflog.Info(ctx, "Renaming user", map[string]any{"sql": stmt})
 		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
 			resp.Diagnostics.AddError("RENAME USER failed", err.Error())
@@ -190,12 +322,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	// Change authentication if type/params changed
-	if plan.AuthType.ValueString() != state.AuthType.ValueString() ||
+	// Change authentication if type/params changed, or if a new password_version asks for rotation
+	passwordRotated := strings.EqualFold(plan.AuthType.ValueString(), "PASSWORD") &&
+		!plan.PasswordVersion.Equal(state.PasswordVersion)
+	if passwordRotated || plan.AuthType.ValueString() != state.AuthType.ValueString() ||
 		plan.Password.ValueString() != state.Password.ValueString() ||
 		plan.LDAPDN.ValueString() != state.LDAPDN.ValueString() ||
 		plan.OpenIDSubject.ValueString() != state.OpenIDSubject.ValueString() {
-		alter, err := buildAlterUserSQL(plan)
+		alter, err := buildAlterUserSQL(plan, r.quoteIdentifiers)
 		if err != nil {
 			resp.Diagnostics.AddError("Invalid alter user config", err.Error())
 			return
@@ -207,15 +341,45 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	user, err := quoteIdent(upNew, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid new user name", err.Error())
+		return
+	}
+	if err := applyComment(ctx, r.db, "USER", user, plan.Comment, state.Comment); err != nil {
+		resp.Diagnostics.AddError("COMMENT ON USER failed", err.Error())
+		return
+	}
+	if err := applyUserConsumerGroup(ctx, r.db, user, plan.ConsumerGroup, state.ConsumerGroup, r.quoteIdentifiers); err != nil {
+		resp.Diagnostics.AddError("ALTER USER SET CONSUMER_GROUP failed", err.Error())
+		return
+	}
+	if err := applyPasswordExpiry(ctx, r.db, user, plan.PasswordExpiryDays, state.PasswordExpiryDays); err != nil {
+		resp.Diagnostics.AddError("ALTER USER SET PASSWORD_EXPIRY_POLICY failed", err.Error())
+		return
+	}
+	if err := applyAccountLock(ctx, r.db, r.collisionRetries, user, plan.AccountLocked, state.AccountLocked); err != nil {
+		resp.Diagnostics.AddError("Changing account lock failed", err.Error())
+		return
+	}
+
 	plan.ID = types.StringValue(upNew)
+	plan.EffectiveConsumerGroup = readEffectiveConsumerGroup(ctx, r.db, upNew)
 	// Keep original name - don't uppercase it (Terraform expects consistency)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Serialize delete operations to prevent transaction collision errors
-	lockDelete()
-	defer unlockDelete()
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_user")
 
 	var state userModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -235,23 +399,26 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	// Escape username for use in quoted identifier
-	escapedName := escapeIdentifierLiteral(upName)
-	stmt := fmt.Sprintf(`DROP USER "%s"`, escapedName)
+	user, err := quoteIdent(upName, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user name", err.Error())
+		return
+	}
+	stmt := fmt.Sprintf(`DROP USER %s`, user)
 	tflog.Info(ctx, "Dropping user", map[string]any{"sql": stmt})
-	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
 		resp.Diagnostics.AddError("DROP USER failed", err.Error())
 	}
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// allow import by username
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by user name; Read fills name from the stored spelling
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.ToUpper(req.ID))...)
 }
 
 // --- helpers -------------------------------------------------------
 
-func buildCreateUserSQL(m userModel) (string, error) {
+func buildCreateUserSQL(m userModel, quoted bool) (string, error) {
 	upName := strings.ToUpper(m.Name.ValueString())
 
 	// Validate identifier
@@ -259,8 +426,10 @@ func buildCreateUserSQL(m userModel) (string, error) {
 		return "", fmt.Errorf("invalid user name: must not be empty")
 	}
 
-	// Escape username for use in quoted identifier
-	escapedName := escapeIdentifierLiteral(upName)
+	user, err := quoteIdent(upName, quoted)
+	if err != nil {
+		return "", err
+	}
 
 	switch strings.ToUpper(m.AuthType.ValueString()) {
 	case "PASSWORD":
@@ -269,27 +438,27 @@ func buildCreateUserSQL(m userModel) (string, error) {
 		}
 		// Escape the password (which is used as an identifier literal in Exasol)
 		escapedPassword := escapeIdentifierLiteral(m.Password.ValueString())
-		return fmt.Sprintf(`CREATE USER "%s" IDENTIFIED BY "%s"`, escapedName, escapedPassword), nil
+		return fmt.Sprintf(`CREATE USER %s IDENTIFIED BY "%s"`, user, escapedPassword), nil
 	case "LDAP":
 		if m.LDAPDN.IsNull() {
 			return "", fmt.Errorf("ldap_dn must be set when auth_type is LDAP")
 		}
 		// Escape the LDAP DN (string literal)
 		escapedDN := escapeStringLiteral(m.LDAPDN.ValueString())
-		return fmt.Sprintf(`CREATE USER "%s" IDENTIFIED AT LDAP AS '%s'`, escapedName, escapedDN), nil
+		return fmt.Sprintf(`CREATE USER %s IDENTIFIED AT LDAP AS '%s'`, user, escapedDN), nil
 	case "OPENID":
 		if m.OpenIDSubject.IsNull() {
 			return "", fmt.Errorf("openid_subject must be set when auth_type is OPENID")
 		}
 		// Escape the OpenID subject (string literal)
 		escapedSubject := escapeStringLiteral(m.OpenIDSubject.ValueString())
-		return fmt.Sprintf(`CREATE USER "%s" IDENTIFIED BY OPENID SUBJECT '%s'`, escapedName, escapedSubject), nil
+		return fmt.Sprintf(`CREATE USER %s IDENTIFIED BY OPENID SUBJECT '%s'`, user, escapedSubject), nil
 	default:
 		return "", fmt.Errorf("unsupported auth_type %q", m.AuthType.ValueString())
 	}
 }
 
-func buildAlterUserSQL(m userModel) (string, error) {
+func buildAlterUserSQL(m userModel, quoted bool) (string, error) {
 	upName := strings.ToUpper(m.Name.ValueString())
 
 	// Validate identifier
@@ -297,8 +466,10 @@ func buildAlterUserSQL(m userModel) (string, error) {
 		return "", fmt.Errorf("invalid user name: must not be empty")
 	}
 
-	// Escape username for use in quoted identifier
-	escapedName := escapeIdentifierLiteral(upName)
+	user, err := quoteIdent(upName, quoted)
+	if err != nil {
+		return "", err
+	}
 
 	switch strings.ToUpper(m.AuthType.ValueString()) {
 	case "PASSWORD":
@@ -307,22 +478,244 @@ func buildAlterUserSQL(m userModel) (string, error) {
 		}
 		// Escape the password (which is used as an identifier literal in Exasol)
 		escapedPassword := escapeIdentifierLiteral(m.Password.ValueString())
-		return fmt.Sprintf(`ALTER USER "%s" IDENTIFIED BY "%s"`, escapedName, escapedPassword), nil
+		return fmt.Sprintf(`ALTER USER %s IDENTIFIED BY "%s"`, user, escapedPassword), nil
 	case "LDAP":
 		if m.LDAPDN.IsNull() {
 			return "", fmt.Errorf("ldap_dn must be set when auth_type is LDAP")
 		}
 		// Escape the LDAP DN (string literal)
 		escapedDN := escapeStringLiteral(m.LDAPDN.ValueString())
-		return fmt.Sprintf(`ALTER USER "%s" IDENTIFIED AT LDAP AS '%s'`, escapedName, escapedDN), nil
+		return fmt.Sprintf(`ALTER USER %s IDENTIFIED AT LDAP AS '%s'`, user, escapedDN), nil
 	case "OPENID":
 		if m.OpenIDSubject.IsNull() {
 			return "", fmt.Errorf("openid_subject must be set when auth_type is OPENID")
 		}
 		// Escape the OpenID subject (string literal)
 		escapedSubject := escapeStringLiteral(m.OpenIDSubject.ValueString())
-		return fmt.Sprintf(`ALTER USER "%s" IDENTIFIED BY OPENID SUBJECT '%s'`, escapedName, escapedSubject), nil
+		return fmt.Sprintf(`ALTER USER %s IDENTIFIED BY OPENID SUBJECT '%s'`, user, escapedSubject), nil
 	default:
 		return "", fmt.Errorf("unsupported auth_type %q", m.AuthType.ValueString())
 	}
 }
+
+// applyUserConsumerGroup assigns the user's consumer group when it changed. Clearing the attribute
+// runs SET CONSUMER_GROUP = NULL, which leaves the user without a group of its own.
+func applyUserConsumerGroup(ctx context.Context, db *sql.DB, user string, plan, prior types.String, quoted bool) error {
+	if plan.IsUnknown() || (plan.IsNull() && prior.IsNull()) ||
+		(!plan.IsNull() && !prior.IsNull() && strings.EqualFold(plan.ValueString(), prior.ValueString())) {
+		return nil
+	}
+	group := "NULL"
+	if !plan.IsNull() {
+		// exasol_consumer_group always stores the name uppercased
+		g, err := quoteIdent(strings.ToUpper(plan.ValueString()), quoted)
+		if err != nil {
+			return err
+		}
+		group = g
+	}
+	stmt := fmt.Sprintf(`ALTER USER %s SET CONSUMER_GROUP = %s`, user, group)
+	tflog.Info(ctx, "Setting user consumer group", map[string]any{"sql": stmt})
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+// reconcileUserConsumerGroup keeps the configured spelling of the consumer group while it maps
+// onto the stored one. A user without a group of its own reads back as null.
+func reconcileUserConsumerGroup(configured types.String, actual sql.NullString) types.String {
+	if !actual.Valid || actual.String == "" {
+		return types.StringNull()
+	}
+	if !configured.IsNull() && strings.ToUpper(configured.ValueString()) == actual.String {
+		return configured
+	}
+	return types.StringValue(actual.String)
+}
+
+// applyPasswordExpiry sets the user's PASSWORD_EXPIRY_POLICY when password_expiry_days changed.
+// Null leaves the policy alone; 0 switches expiry off for the user.
+func applyPasswordExpiry(ctx context.Context, db *sql.DB, user string, plan, prior types.Int64) error {
+	if plan.IsNull() || plan.IsUnknown() || (!prior.IsNull() && prior.ValueInt64() == plan.ValueInt64()) {
+		return nil
+	}
+	policy := "OFF"
+	if plan.ValueInt64() > 0 {
+		policy = fmt.Sprintf("EXPIRY_DAYS=%d", plan.ValueInt64())
+	}
+	stmt := fmt.Sprintf(`ALTER USER %s SET PASSWORD_EXPIRY_POLICY = '%s'`, user, policy)
+	tflog.Info(ctx, "Setting user password expiry", map[string]any{"sql": stmt})
+	_, err := db.ExecContext(ctx, stmt)
+	return err
+}
+
+// readUserColumn reads a column of the user from view. A view or column this Exasol version does
+// not have, or the provider user cannot read, fails the query; ok is false then so the caller
+// leaves the attribute unmanaged instead of failing the Read.
+func readUserColumn(ctx context.Context, db *sql.DB, view, column, user string) (value sql.NullString, ok bool) {
+	err := retryRead(ctx, func() error {
+		return db.QueryRowContext(ctx,
+			fmt.Sprintf(`SELECT %s FROM %s WHERE USER_NAME = ?`, column, view), user).Scan(&value)
+	})
+	if err != nil {
+		tflog.Debug(ctx, "Could not read user column", map[string]any{"user": user, "view": view, "column": column, "error": err.Error()})
+		return sql.NullString{}, false
+	}
+	return value, true
+}
+
+// passwordExpiryDays extracts EXPIRY_DAYS from a PASSWORD_EXPIRY_POLICY such as
+// 'EXPIRY_DAYS=180:GRACE_DAYS=30'. NULL, OFF and policies without EXPIRY_DAYS read as 0.
+func passwordExpiryDays(policy sql.NullString) int64 {
+	for _, part := range strings.Split(policy.String, ":") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), "EXPIRY_DAYS") {
+			continue
+		}
+		if days, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			return days
+		}
+	}
+	return 0
+}
+
+// applyAccountLock revokes (lock) or grants (unlock) CREATE SESSION when account_locked changed.
+func applyAccountLock(ctx context.Context, db *sql.DB, retries int, user string, plan, prior types.Bool) error {
+	if plan.IsNull() || plan.IsUnknown() || (!prior.IsNull() && prior.ValueBool() == plan.ValueBool()) {
+		return nil
+	}
+	stmt := fmt.Sprintf(`GRANT CREATE SESSION TO %s`, user)
+	if plan.ValueBool() {
+		stmt = fmt.Sprintf(`REVOKE CREATE SESSION FROM %s`, user)
+	}
+	tflog.Info(ctx, "Changing user account lock", map[string]any{"sql": stmt})
+	return execWithCollisionRetry(ctx, db, retries, stmt)
+}
+
+// hasDirectCreateSession reports whether CREATE SESSION is granted to the user itself.
+func hasDirectCreateSession(ctx context.Context, db *sql.DB, user string) (bool, error) {
+	var n int
+	err := retryRead(ctx, func() error {
+		return db.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM EXA_DBA_SYS_PRIVS WHERE GRANTEE = ? AND PRIVILEGE = 'CREATE SESSION'`, user).Scan(&n)
+	})
+	return n > 0, err
+}
+
+// userAuthColumns are the EXA_DBA_USERS columns that identify a user's authentication, in the
+// order they are checked. Not every Exasol version has all of them (OPENID_SUBJECT is 8.x only).
+var userAuthColumns = []string{"DISTINGUISHED_NAME", "KERBEROS_PRINCIPAL", "OPENID_SUBJECT", "PASSWORD"}
+
+// detectUserAuthType reads the authentication of a user from EXA_DBA_USERS. It first looks up which
+// of userAuthColumns the server provides and then selects only those. detail is the LDAP DN,
+// Kerberos principal or OpenID subject; it is empty for PASSWORD users.
+func detectUserAuthType(ctx context.Context, db *sql.DB, name string) (authType, detail string, err error) {
+	var present []string
+	err = retryRead(ctx, func() error {
+		present = nil
+		rows, err := db.QueryContext(ctx, `SELECT COLUMN_NAME FROM EXA_SYS_COLUMNS
+			WHERE COLUMN_SCHEMA = 'SYS' AND COLUMN_TABLE = 'EXA_DBA_USERS'
+			AND COLUMN_NAME IN ('`+strings.Join(userAuthColumns, "', '")+`')`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var col string
+			if err := rows.Scan(&col); err != nil {
+				return err
+			}
+			present = append(present, col)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if len(present) == 0 {
+		return "", "", fmt.Errorf("EXA_DBA_USERS has none of the columns %s", strings.Join(userAuthColumns, ", "))
+	}
+
+	values := make([]sql.NullString, len(present))
+	dest := make([]any, len(present))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	err = retryRead(ctx, func() error {
+		return db.QueryRowContext(ctx,
+			`SELECT `+strings.Join(present, ", ")+` FROM EXA_DBA_USERS WHERE USER_NAME = ?`, name).Scan(dest...)
+	})
+	if err != nil {
+		return "", "", err
+	}
+	columns := make(map[string]sql.NullString, len(present))
+	for i, col := range present {
+		columns[col] = values[i]
+	}
+	authType, detail = userAuthFromColumns(columns)
+	return authType, detail, nil
+}
+
+// userAuthFromColumns maps the EXA_DBA_USERS auth columns onto an auth_type. A user has exactly one
+// kind of authentication, so the first non-empty identity column decides; a user with none of them
+// authenticates by password. Missing columns count as empty.
+func userAuthFromColumns(columns map[string]sql.NullString) (authType, detail string) {
+	for _, c := range []struct{ column, authType string }{
+		{"DISTINGUISHED_NAME", "LDAP"},
+		{"KERBEROS_PRINCIPAL", "KERBEROS"},
+		{"OPENID_SUBJECT", "OPENID"},
+	} {
+		if v := columns[c.column]; v.Valid && v.String != "" {
+			return c.authType, v.String
+		}
+	}
+	return "PASSWORD", ""
+}
+
+// reconcileUserAuth writes the detected authentication into state. Matching values keep their
+// configured spelling; the password is never read back.
+func reconcileUserAuth(state *userModel, authType, detail string) {
+	if !strings.EqualFold(state.AuthType.ValueString(), authType) {
+		state.AuthType = types.StringValue(authType)
+	}
+	switch authType {
+	case "LDAP":
+		if state.LDAPDN.ValueString() != detail {
+			state.LDAPDN = types.StringValue(detail)
+		}
+	case "OPENID":
+		if state.OpenIDSubject.ValueString() != detail {
+			state.OpenIDSubject = types.StringValue(detail)
+		}
+	}
+}
+
+// readEffectiveConsumerGroup resolves the consumer group a user's sessions run under, following
+// Exasol's precedence: the user's own consumer group, then the highest-precedence group among the
+// roles granted directly to the user, then DEFAULT_CONSUMER_GROUP. It is informational only, so a
+// failure (pre-7.0 server without consumer groups, or no access to EXA_DBA_*) yields null.
+func readEffectiveConsumerGroup(ctx context.Context, db *sql.DB, user string) types.String {
+	var group sql.NullString
+	err := retryRead(ctx, func() error {
+		return db.QueryRowContext(ctx,
+			`SELECT USER_CONSUMER_GROUP FROM EXA_DBA_USERS WHERE USER_NAME = ?`, user).Scan(&group)
+	})
+	if err == nil && (!group.Valid || group.String == "") {
+		err = retryRead(ctx, func() error {
+			return db.QueryRowContext(ctx,
+				`SELECT R.ROLE_CONSUMER_GROUP FROM EXA_DBA_ROLE_PRIVS P `+
+					`JOIN EXA_DBA_ROLES R ON R.ROLE_NAME = P.GRANTED_ROLE `+
+					`JOIN EXA_CONSUMER_GROUPS G ON G.CONSUMER_GROUP_NAME = R.ROLE_CONSUMER_GROUP `+
+					`WHERE P.GRANTEE = ? ORDER BY G.PRECEDENCE DESC LIMIT 1`, user).Scan(&group)
+		})
+		if err == sql.ErrNoRows {
+			var def string
+			def, err = readDefaultConsumerGroup(ctx, db)
+			group = sql.NullString{String: def, Valid: def != ""}
+		}
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Could not resolve effective consumer group", map[string]any{"user": user, "error": err.Error()})
+		return types.StringNull()
+	}
+	return nullableString(group)
+}
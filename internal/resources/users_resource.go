@@ -0,0 +1,468 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UsersResource{}
+var _ resource.ResourceWithValidateConfig = &UsersResource{}
+
+// UsersResource manages a set of users from a single map, e.g. for onboarding many service accounts.
+// Each entry is created, altered and dropped with the same SQL as UserResource.
+type UsersResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+func NewUsersResource() resource.Resource { return &UsersResource{} }
+
+func (r *UsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (r *UsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates, alters and drops a set of Exasol users keyed by user name. " +
+			"Updates only touch the users that were added, removed or whose authentication changed. " +
+			"Renaming a key drops the old user and creates a new one.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Users keyed by user name (case-insensitive). Must not be empty.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_type": schema.StringAttribute{
+							Required:    true,
+							Description: `Authentication type: "PASSWORD", "LDAP" or "OPENID".`,
+						},
+						"password": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Password for PASSWORD authentication.",
+						},
+						"ldap_dn": schema.StringAttribute{
+							Optional:    true,
+							Description: "LDAP distinguished name if auth_type is LDAP.",
+						},
+						"openid_subject": schema.StringAttribute{
+							Optional:    true,
+							Description: "OpenID subject if auth_type is OPENID.",
+						},
+					},
+				},
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform ID in format: USER1,USER2",
+			},
+		},
+	}
+}
+
+func (r *UsersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type usersModel struct {
+	ID             types.String               `tfsdk:"id"`
+	Users          map[string]usersEntryModel `tfsdk:"users"`
+	LastAppliedSQL types.String               `tfsdk:"last_applied_sql"`
+}
+
+type usersEntryModel struct {
+	AuthType      types.String `tfsdk:"auth_type"`
+	Password      types.String `tfsdk:"password"`
+	LDAPDN        types.String `tfsdk:"ldap_dn"`
+	OpenIDSubject types.String `tfsdk:"openid_subject"`
+}
+
+// userModel converts the entry into the single-user model so the UserResource SQL builders can be reused.
+func (e usersEntryModel) userModel(name string) userModel {
+	return userModel{
+		Name:          types.StringValue(name),
+		AuthType:      e.AuthType,
+		Password:      e.Password,
+		LDAPDN:        e.LDAPDN,
+		OpenIDSubject: e.OpenIDSubject,
+	}
+}
+
+// authChanged reports whether the entry needs an ALTER USER, using the same comparison as UserResource.Update.
+func (e usersEntryModel) authChanged(other usersEntryModel) bool {
+	return e.AuthType.ValueString() != other.AuthType.ValueString() ||
+		e.Password.ValueString() != other.Password.ValueString() ||
+		e.LDAPDN.ValueString() != other.LDAPDN.ValueString() ||
+		e.OpenIDSubject.ValueString() != other.OpenIDSubject.ValueString()
+}
+
+func (r *UsersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var users types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("users"), &users)...)
+	if resp.Diagnostics.HasError() || users.IsNull() || users.IsUnknown() {
+		return
+	}
+	// The ID is derived from the user names, so an empty map would have no ID and be
+	// recreated on every plan.
+	if len(users.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("users"), "No users", "users must contain at least one user.")
+		return
+	}
+
+	names := make([]string, 0, len(users.Elements()))
+	for name := range users.Elements() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	seen := map[string]string{}
+	for _, name := range names {
+		up := strings.ToUpper(name)
+		if !isValidIdentifier(up) {
+			resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(name), "Invalid user name", "User name must not be empty.")
+			continue
+		}
+		if prev, ok := seen[up]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(name), "Duplicate user",
+				fmt.Sprintf("Users %q and %q are the same Exasol user; user names are case-insensitive.", prev, name))
+			continue
+		}
+		seen[up] = name
+	}
+}
+
+func (r *UsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_users")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan usersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	// Stop at the first failure without saving state, like exasol_role_assignments. A partial
+	// state would be tainted and replaced on the next apply, dropping the users that were created,
+	// so the users created so far are dropped again instead and the next apply starts over. The
+	// drops run on their own context, as ctx may just have hit query_timeout.
+	var created []string
+	for _, name := range sortedUserNames(plan.Users) {
+		ok := r.createUser(ctx, name, plan.Users[name], &resp.Diagnostics)
+		if ok {
+			created = append(created, name)
+		}
+		if !ok || resp.Diagnostics.HasError() {
+			rctx, cancel := withTimeout(context.WithoutCancel(ctx), r.deleteTimeout)
+			defer cancel()
+			for _, c := range created {
+				r.dropUser(rctx, c, &resp.Diagnostics)
+			}
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(usersID(plan.Users))
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var state usersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var existing map[string]bool
+	err := retryRead(ctx, func() error {
+		var err error
+		existing, err = readExistingUsers(ctx, r.db, sortedUserNames(state.Users))
+		return err
+	})
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read users failed", err)
+		return
+	}
+
+	// Drop users that no longer exist so Terraform plans to create them again
+	for name := range state.Users {
+		if !existing[strings.ToUpper(name)] {
+			delete(state.Users, name)
+		}
+	}
+	if len(state.Users) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(usersID(state.Users))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_users")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, state usersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	// Changes that fail keep their old state, so a partial failure still leaves an accurate state behind
+	applied := make(map[string]usersEntryModel, len(plan.Users))
+	for name, entry := range plan.Users {
+		applied[name] = entry
+	}
+
+	toDrop, toCreate, toAlter := diffUsers(state.Users, plan.Users)
+	for _, name := range toDrop {
+		if !r.dropUser(ctx, name, &resp.Diagnostics) {
+			applied[name] = state.Users[name]
+		}
+	}
+	for _, name := range toCreate {
+		if !r.createUser(ctx, name, plan.Users[name], &resp.Diagnostics) {
+			delete(applied, name)
+		}
+	}
+	for _, name := range toAlter {
+		if !r.alterUser(ctx, name, plan.Users[name], &resp.Diagnostics) {
+			delete(applied, name)
+			for old, entry := range state.Users {
+				if strings.EqualFold(old, name) {
+					applied[old] = entry
+				}
+			}
+		}
+	}
+
+	plan.Users = applied
+	plan.ID = types.StringValue(usersID(applied))
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, state.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_users")
+
+	var state usersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	for _, name := range sortedUserNames(state.Users) {
+		r.dropUser(ctx, name, &resp.Diagnostics)
+	}
+}
+
+func (r *UsersResource) createUser(ctx context.Context, name string, entry usersEntryModel, diags *diag.Diagnostics) bool {
+	stmt, err := buildCreateUserSQL(entry.userModel(name), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddAttributeError(path.Root("users").AtMapKey(name), "Invalid user configuration",
+			fmt.Sprintf("User %s: %s", name, err))
+		return false
+	}
+	tflog.Info(ctx, "Creating user", map[string]any{"sql": sanitizeLogSQL(stmt)})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		diags.AddError(fmt.Sprintf("CREATE USER %s failed", strings.ToUpper(name)), err.Error())
+		return false
+	}
+
+	// also grant CREATE SESSION so user can log in
+	user, err := quoteIdent(strings.ToUpper(name), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid user name %s", name), err.Error())
+		return false
+	}
+	grant := fmt.Sprintf(`GRANT CREATE SESSION TO %s`, user)
+	if _, err := r.db.ExecContext(ctx, grant); err != nil {
+		// The user exists; Create drops it again, Update keeps it in state
+		diags.AddError(fmt.Sprintf("Grant CREATE SESSION to %s failed", strings.ToUpper(name)), err.Error())
+	}
+	return true
+}
+
+func (r *UsersResource) alterUser(ctx context.Context, name string, entry usersEntryModel, diags *diag.Diagnostics) bool {
+	stmt, err := buildAlterUserSQL(entry.userModel(name), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddAttributeError(path.Root("users").AtMapKey(name), "Invalid alter user config",
+			fmt.Sprintf("User %s: %s", name, err))
+		return false
+	}
+	tflog.Info(ctx, "Altering user", map[string]any{"sql": sanitizeLogSQL(stmt)})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		diags.AddError(fmt.Sprintf("ALTER USER %s failed", strings.ToUpper(name)), err.Error())
+		return false
+	}
+	return true
+}
+
+func (r *UsersResource) dropUser(ctx context.Context, name string, diags *diag.Diagnostics) bool {
+	user, err := quoteIdent(strings.ToUpper(name), r.quoteIdentifiers)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid user name %s", name), err.Error())
+		return false
+	}
+	stmt := fmt.Sprintf(`DROP USER %s`, user)
+	tflog.Info(ctx, "Dropping user", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		diags.AddError(fmt.Sprintf("DROP USER %s failed", strings.ToUpper(name)), err.Error())
+		return false
+	}
+	return true
+}
+
+// diffUsers returns the users to drop (by old key), create and alter (by new key) to move from
+// oldUsers to newUsers. Keys are matched case-insensitively, like Exasol user names.
+func diffUsers(oldUsers, newUsers map[string]usersEntryModel) (toDrop, toCreate, toAlter []string) {
+	oldByUpper := make(map[string]string, len(oldUsers))
+	for name := range oldUsers {
+		oldByUpper[strings.ToUpper(name)] = name
+	}
+	newByUpper := make(map[string]string, len(newUsers))
+	for name := range newUsers {
+		newByUpper[strings.ToUpper(name)] = name
+	}
+
+	for _, name := range sortedUserNames(oldUsers) {
+		if _, ok := newByUpper[strings.ToUpper(name)]; !ok {
+			toDrop = append(toDrop, name)
+		}
+	}
+	for _, name := range sortedUserNames(newUsers) {
+		oldName, ok := oldByUpper[strings.ToUpper(name)]
+		if !ok {
+			toCreate = append(toCreate, name)
+			continue
+		}
+		if newUsers[name].authChanged(oldUsers[oldName]) {
+			toAlter = append(toAlter, name)
+		}
+	}
+	return toDrop, toCreate, toAlter
+}
+
+func sortedUserNames(users map[string]usersEntryModel) []string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readExistingUsers returns the uppercase names of the given users that exist, with a single query.
+func readExistingUsers(ctx context.Context, db *sql.DB, names []string) (map[string]bool, error) {
+	if len(names) == 0 {
+		return map[string]bool{}, nil
+	}
+	placeholders := make([]string, len(names))
+	args := make([]any, len(names))
+	for i, name := range names {
+		placeholders[i] = "?"
+		args[i] = strings.ToUpper(name)
+	}
+	query := fmt.Sprintf(`SELECT USER_NAME FROM EXA_ALL_USERS WHERE USER_NAME IN (%s)`, strings.Join(placeholders, ", "))
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	existing := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		existing[name] = true
+	}
+	return existing, rows.Err()
+}
+
+func usersID(users map[string]usersEntryModel) string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, strings.ToUpper(name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
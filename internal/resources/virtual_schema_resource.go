@@ -0,0 +1,495 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-exasol/internal/exasolclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// VirtualSchemaResource manages virtual schemas.
+type VirtualSchemaResource struct {
+	db                   *sql.DB
+	quoteIdentifiers     bool
+	queryTimeout         time.Duration
+	readTimeout          time.Duration
+	deleteTimeout        time.Duration
+	collisionRetries     int
+	readPermissionPolicy string
+	operations           *exasolclient.OperationLimiter
+}
+
+var _ resource.Resource = &VirtualSchemaResource{}
+var _ resource.ResourceWithImportState = &VirtualSchemaResource{}
+var _ resource.ResourceWithValidateConfig = &VirtualSchemaResource{}
+
+func NewVirtualSchemaResource() resource.Resource { return &VirtualSchemaResource{} }
+
+// virtualSchemaConnectionProperty is the adapter property the connection_name attribute sets.
+const virtualSchemaConnectionProperty = "CONNECTION_NAME"
+
+func (r *VirtualSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_schema"
+}
+
+func (r *VirtualSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and drops an Exasol virtual schema. Property changes are applied with " +
+			"ALTER VIRTUAL SCHEMA ... SET; the schema is dropped with CASCADE.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Description: "Virtual schema name, resolved like exasol_schema names: the exact spelling with quote_identifiers, " +
+					"uppercased otherwise. Changing it recreates the virtual schema.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(r.virtualSchemaRenamed,
+						"The virtual schema is created anew.", "The virtual schema is created anew."),
+				},
+			},
+			"adapter_script": schema.StringAttribute{
+				Required: true,
+				Description: "Adapter script as SCHEMA.SCRIPT, resolved like exasol_script: the schema like exasol_schema names, " +
+					"the script name uppercased. Changing it recreates the virtual schema.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(r.adapterScriptChanged,
+						"The virtual schema is created anew.", "The virtual schema is created anew."),
+				},
+			},
+			"connection_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Connection the adapter uses, set as the CONNECTION_NAME property.",
+			},
+			"properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Adapter properties, e.g. SCHEMA_NAME or TABLE_FILTER. Names are case-insensitive. " +
+					"Removing a property unsets it.",
+			},
+			"refresh_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: "Any change of this value runs ALTER VIRTUAL SCHEMA ... REFRESH, e.g. after tables were " +
+					"added to the source.",
+			},
+			"last_applied_sql": lastAppliedSQLAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Virtual schema name as stored in Exasol.",
+			},
+		},
+	}
+}
+
+// virtualSchemaRenamed replaces the virtual schema only when its stored name changes;
+// spelling-only changes are applied in place.
+func (r *VirtualSchemaResource) virtualSchemaRenamed(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = r.virtualSchemaID(req.StateValue.ValueString()) != r.virtualSchemaID(req.PlanValue.ValueString())
+}
+
+// adapterScriptChanged replaces the virtual schema only when the stored adapter script changes.
+func (r *VirtualSchemaResource) adapterScriptChanged(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = r.adapterScriptID(req.StateValue.ValueString()) != r.adapterScriptID(req.PlanValue.ValueString())
+}
+
+// virtualSchemaID is the name of a virtual schema as Exasol stores it, resolved like exasol_schema names.
+func (r *VirtualSchemaResource) virtualSchemaID(name string) string {
+	return canonicalIdent(unquoteIdent(name), r.quoteIdentifiers)
+}
+
+// adapterScriptID is the SCHEMA.SCRIPT of an adapter script as Exasol stores it, see scriptID.
+func (r *VirtualSchemaResource) adapterScriptID(adapter string) string {
+	schemaName, script, _ := strings.Cut(adapter, ".")
+	return scriptID(schemaName, script, r.quoteIdentifiers)
+}
+
+func (r *VirtualSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	if c, ok := req.ProviderData.(*exasolclient.Client); ok {
+		r.db = c.DB
+		r.quoteIdentifiers = c.QuoteIdentifiers
+		r.queryTimeout = c.QueryTimeout
+		r.readTimeout = c.ReadTimeout
+		r.deleteTimeout = c.DeleteTimeout
+		r.collisionRetries = c.CollisionRetries
+		r.readPermissionPolicy = c.ReadPermissionPolicy
+		r.operations = c.Operations
+	}
+}
+
+type virtualSchemaModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	AdapterScript  types.String `tfsdk:"adapter_script"`
+	ConnectionName types.String `tfsdk:"connection_name"`
+	Properties     types.Map    `tfsdk:"properties"`
+	RefreshTrigger types.String `tfsdk:"refresh_trigger"`
+	LastAppliedSQL types.String `tfsdk:"last_applied_sql"`
+}
+
+func (r *VirtualSchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg virtualSchemaModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !cfg.Name.IsNull() && !cfg.Name.IsUnknown() && !isValidIdentifier(upper(cfg.Name.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid name",
+			fmt.Sprintf("Virtual schema name %q must start with a letter and contain only letters, digits, and underscores.", cfg.Name.ValueString()))
+	}
+	if !cfg.AdapterScript.IsNull() && !cfg.AdapterScript.IsUnknown() {
+		schemaName, script, ok := strings.Cut(cfg.AdapterScript.ValueString(), ".")
+		if !ok || !isValidIdentifier(upper(schemaName)) || !isValidIdentifier(upper(script)) {
+			resp.Diagnostics.AddAttributeError(path.Root("adapter_script"), "Invalid adapter_script",
+				fmt.Sprintf("adapter_script must be SCHEMA.SCRIPT, got %q.", cfg.AdapterScript.ValueString()))
+		}
+	}
+	if cfg.Properties.IsNull() || cfg.Properties.IsUnknown() {
+		return
+	}
+	for name := range cfg.Properties.Elements() {
+		switch {
+		case !isValidIdentifier(upper(name)):
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Invalid property name",
+				fmt.Sprintf("Property name %q must start with a letter and contain only letters, digits, and underscores.", name))
+		case upper(name) == virtualSchemaConnectionProperty && !cfg.ConnectionName.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting connection settings",
+				"Set the connection either with connection_name or with a CONNECTION_NAME property, not both.")
+		}
+	}
+}
+
+func (r *VirtualSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_virtual_schema")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan virtualSchemaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	props := virtualSchemaProperties(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := r.virtualSchemaID(plan.Name.ValueString())
+	stmt, err := buildCreateVirtualSchemaSQL(name, r.adapterScriptID(plan.AdapterScript.ValueString()), props, r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid virtual schema", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Creating virtual schema", map[string]any{"sql": stmt})
+	if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+		resp.Diagnostics.AddError("CREATE VIRTUAL SCHEMA failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VirtualSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.readTimeout)
+	defer cancel()
+
+	var state virtualSchemaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	var adapterSchema, adapterName sql.NullString
+	var stored map[string]string
+	err := retryRead(ctx, func() error {
+		err := r.db.QueryRowContext(ctx,
+			`SELECT ADAPTER_SCRIPT_SCHEMA, ADAPTER_SCRIPT_NAME FROM EXA_ALL_VIRTUAL_SCHEMAS WHERE SCHEMA_NAME = ?`,
+			state.ID.ValueString()).Scan(&adapterSchema, &adapterName)
+		if err != nil {
+			return err
+		}
+		stored, err = readVirtualSchemaProperties(ctx, r.db, state.ID.ValueString())
+		return err
+	})
+	if err == sql.ErrNoRows {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		readFailed(ctx, r.readPermissionPolicy, &resp.Diagnostics, "Read virtual schema failed", err)
+		return
+	}
+
+	state.Name = reconcileName(state.Name, state.ID.ValueString(), r.virtualSchemaID)
+	if adapterSchema.Valid && adapterName.Valid {
+		state.AdapterScript = reconcileName(state.AdapterScript, adapterSchema.String+"."+adapterName.String, r.adapterScriptID)
+	}
+
+	// connection_name owns the CONNECTION_NAME property when it is set (or after an import,
+	// where every property lands in properties)
+	if !state.ConnectionName.IsNull() {
+		if conn, ok := stored[virtualSchemaConnectionProperty]; ok {
+			state.ConnectionName = reconcileName(state.ConnectionName, conn, upper)
+		} else {
+			state.ConnectionName = types.StringNull()
+		}
+		delete(stored, virtualSchemaConnectionProperty)
+	}
+	properties, diags := reconcileVirtualSchemaProperties(ctx, state.Properties, stored)
+	resp.Diagnostics.Append(diags...)
+	state.Properties = properties
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VirtualSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_virtual_schema")
+	ctx, recorder := exasolclient.WithStatementRecorder(ctx)
+
+	var plan, prior virtualSchemaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	schemaIdent, err := quoteIdent(prior.ID.ValueString(), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid virtual schema name", err.Error())
+		return
+	}
+	newProps := virtualSchemaProperties(ctx, plan, &resp.Diagnostics)
+	oldProps := virtualSchemaProperties(ctx, prior, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if set := virtualSchemaPropertyChanges(oldProps, newProps); set != "" {
+		stmt := fmt.Sprintf(`ALTER VIRTUAL SCHEMA %s SET %s`, schemaIdent, set)
+		tflog.Info(ctx, "Altering virtual schema properties", map[string]any{"sql": stmt})
+		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+			resp.Diagnostics.AddError("ALTER VIRTUAL SCHEMA failed", err.Error())
+			return
+		}
+	}
+	if plan.RefreshTrigger.ValueString() != prior.RefreshTrigger.ValueString() && !plan.RefreshTrigger.IsNull() {
+		stmt := fmt.Sprintf(`ALTER VIRTUAL SCHEMA %s REFRESH`, schemaIdent)
+		tflog.Info(ctx, "Refreshing virtual schema", map[string]any{"sql": stmt})
+		if _, err := r.db.ExecContext(ctx, stmt); err != nil {
+			resp.Diagnostics.AddError("REFRESH VIRTUAL SCHEMA failed", err.Error())
+			return
+		}
+	}
+
+	plan.ID = prior.ID
+	plan.LastAppliedSQL = lastAppliedSQL(recorder, prior.LastAppliedSQL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VirtualSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	defer reportDeleteTimeout(ctx, &resp.Diagnostics, r.deleteTimeout)
+	release, ok := acquireOperation(ctx, r.operations, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+	ctx = exasolclient.WithAuditResource(ctx, "exasol_virtual_schema")
+
+	var state virtualSchemaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.db == nil {
+		resp.Diagnostics.AddError("Database not configured", "Provider did not supply a database connection.")
+		return
+	}
+
+	schemaIdent, err := quoteIdent(state.ID.ValueString(), r.quoteIdentifiers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid virtual schema name", err.Error())
+		return
+	}
+	stmt := fmt.Sprintf(`DROP VIRTUAL SCHEMA %s CASCADE`, schemaIdent)
+	tflog.Info(ctx, "Dropping virtual schema", map[string]any{"sql": stmt})
+	if err := execWithCollisionRetry(ctx, r.db, r.collisionRetries, stmt); err != nil {
+		resp.Diagnostics.AddError("DROP VIRTUAL SCHEMA failed", err.Error())
+	}
+}
+
+func (r *VirtualSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by name; Read fills name, adapter_script and properties. A CONNECTION_NAME property
+	// stays in properties until connection_name is configured.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.virtualSchemaID(req.ID))...)
+}
+
+// virtualSchemaProperties returns the adapter properties of m keyed by upper-case name,
+// including CONNECTION_NAME from connection_name.
+func virtualSchemaProperties(ctx context.Context, m virtualSchemaModel, diags *diag.Diagnostics) map[string]string {
+	var configured map[string]string
+	if !m.Properties.IsNull() && !m.Properties.IsUnknown() {
+		diags.Append(m.Properties.ElementsAs(ctx, &configured, false)...)
+	}
+	props := make(map[string]string, len(configured)+1)
+	for k, v := range configured {
+		props[upper(k)] = v
+	}
+	if !m.ConnectionName.IsNull() && !m.ConnectionName.IsUnknown() {
+		props[virtualSchemaConnectionProperty] = upper(m.ConnectionName.ValueString())
+	}
+	return props
+}
+
+// renderVirtualSchemaProperties renders NAME='value' assignments sorted by name. A nil value
+// pointer renders NAME=NULL, which removes the property.
+func renderVirtualSchemaProperties(props map[string]*string) string {
+	names := make([]string, 0, len(props))
+	for k := range props {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, k := range names {
+		if v := props[k]; v != nil {
+			parts = append(parts, fmt.Sprintf(`%s='%s'`, k, escapeStringLiteral(*v)))
+		} else {
+			parts = append(parts, k+"=NULL")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func buildCreateVirtualSchemaSQL(name, adapter string, props map[string]string, quoted bool) (string, error) {
+	if !isValidIdentifier(name) {
+		return "", fmt.Errorf("invalid virtual schema name %q", name)
+	}
+	schemaIdent, err := quoteIdent(name, quoted)
+	if err != nil {
+		return "", err
+	}
+	adapterIdent, err := qualifyIdent(adapter, quoted)
+	if err != nil {
+		return "", err
+	}
+	stmt := fmt.Sprintf(`CREATE VIRTUAL SCHEMA %s USING %s`, schemaIdent, adapterIdent)
+	if len(props) == 0 {
+		return stmt, nil
+	}
+	values := make(map[string]*string, len(props))
+	for k, v := range props {
+		if !isValidIdentifier(k) {
+			return "", fmt.Errorf("invalid property name %q", k)
+		}
+		values[k] = &v
+	}
+	return stmt + " WITH " + renderVirtualSchemaProperties(values), nil
+}
+
+// virtualSchemaPropertyChanges renders the SET assignments turning the old properties into the
+// new ones: changed and added properties get their value, removed ones are set to NULL.
+func virtualSchemaPropertyChanges(oldProps, newProps map[string]string) string {
+	changes := make(map[string]*string)
+	for k, v := range newProps {
+		if old, ok := oldProps[k]; !ok || old != v {
+			changes[k] = &v
+		}
+	}
+	for k := range oldProps {
+		if _, ok := newProps[k]; !ok {
+			changes[k] = nil
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return renderVirtualSchemaProperties(changes)
+}
+
+// readVirtualSchemaProperties returns the properties of a virtual schema by upper-case name.
+func readVirtualSchemaProperties(ctx context.Context, db *sql.DB, name string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT PROPERTY_NAME, PROPERTY_VALUE FROM EXA_ALL_VIRTUAL_SCHEMA_PROPERTIES WHERE SCHEMA_NAME = ?`, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	props := map[string]string{}
+	for rows.Next() {
+		var k string
+		var v sql.NullString
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, err
+		}
+		props[upper(k)] = v.String
+	}
+	return props, rows.Err()
+}
+
+// reconcileVirtualSchemaProperties builds the properties attribute from the stored properties,
+// keeping the configured spelling of each name. An unset attribute stays null while nothing is
+// stored, so a virtual schema without properties does not show a diff.
+func reconcileVirtualSchemaProperties(ctx context.Context, configured types.Map, stored map[string]string) (types.Map, diag.Diagnostics) {
+	if len(stored) == 0 && configured.IsNull() {
+		return configured, nil
+	}
+	var current map[string]string
+	var diags diag.Diagnostics
+	if !configured.IsNull() && !configured.IsUnknown() {
+		diags.Append(configured.ElementsAs(ctx, &current, false)...)
+	}
+	spelling := make(map[string]string, len(current))
+	for k := range current {
+		spelling[upper(k)] = k
+	}
+	out := make(map[string]string, len(stored))
+	for k, v := range stored {
+		if name, ok := spelling[k]; ok {
+			k = name
+		}
+		out[k] = v
+	}
+	m, d := types.MapValueFrom(ctx, types.StringType, out)
+	diags.Append(d...)
+	return m, diags
+}